@@ -0,0 +1,731 @@
+/**
+ * JSONNLP package
+ * (C) 2020 by Semiring Inc., Damir Cavar
+ *
+ * conversion between the JSONNLP structs and their pb.Document wire
+ * representation, for use with the AnnotationService gRPC service.
+ */
+
+package jsonnlp
+
+import (
+	"github.com/SemiringInc/jsonnlp/pb"
+)
+
+// ToProto converts a Document into its pb.Document wire representation.
+func ToProto(d *Document) *pb.Document {
+	if d == nil {
+		return nil
+	}
+	out := &pb.Document{
+		Meta: metaToProto(d.MetaDocument),
+		Id:   int32(d.ID),
+	}
+	for _, t := range d.TokenList {
+		out.TokenList = append(out.TokenList, tokenToProto(t))
+	}
+	for _, c := range d.Clauses {
+		out.Clauses = append(out.Clauses, clauseToProto(c))
+	}
+	for _, s := range d.Sentences {
+		out.Sentences = append(out.Sentences, sentenceToProto(s))
+	}
+	for _, p := range d.Paragraphs {
+		out.Paragraphs = append(out.Paragraphs, paragraphToProto(p))
+	}
+	for _, dt := range d.DependencyTrees {
+		out.DependencyTrees = append(out.DependencyTrees, dependencyTreeToProto(dt))
+	}
+	for _, c := range d.Coreferences {
+		out.Coreferences = append(out.Coreferences, coreferenceToProto(c))
+	}
+	for _, cp := range d.Constituents {
+		out.Constituents = append(out.Constituents, constituentParseToProto(cp))
+	}
+	for _, e := range d.Expressions {
+		out.Expressions = append(out.Expressions, expressionToProto(e))
+	}
+	for _, e := range d.Entities {
+		out.Entities = append(out.Entities, entityToProto(e))
+	}
+	for _, r := range d.Relations {
+		out.Relations = append(out.Relations, relationToProto(r))
+	}
+	for _, tr := range d.Triples {
+		out.Triples = append(out.Triples, tripleToProto(tr))
+	}
+	for _, tx := range d.Timexes {
+		out.Timexes = append(out.Timexes, timexToProto(tx))
+	}
+	for _, q := range d.Quotes {
+		out.Quotes = append(out.Quotes, quoteToProto(q))
+	}
+	for _, m := range d.Mentions {
+		out.Mentions = append(out.Mentions, nerMentionToProto(m))
+	}
+	return out
+}
+
+// FromProto converts a pb.Document back into a Document.
+func FromProto(d *pb.Document) *Document {
+	if d == nil {
+		return nil
+	}
+	out := &Document{
+		MetaDocument: metaFromProto(d.GetMeta()),
+		ID:           int(d.GetId()),
+	}
+	for _, t := range d.GetTokenList() {
+		out.TokenList = append(out.TokenList, tokenFromProto(t))
+	}
+	for _, c := range d.GetClauses() {
+		out.Clauses = append(out.Clauses, clauseFromProto(c))
+	}
+	for _, s := range d.GetSentences() {
+		out.Sentences = append(out.Sentences, sentenceFromProto(s))
+	}
+	for _, p := range d.GetParagraphs() {
+		out.Paragraphs = append(out.Paragraphs, paragraphFromProto(p))
+	}
+	for _, dt := range d.GetDependencyTrees() {
+		out.DependencyTrees = append(out.DependencyTrees, dependencyTreeFromProto(dt))
+	}
+	for _, c := range d.GetCoreferences() {
+		out.Coreferences = append(out.Coreferences, coreferenceFromProto(c))
+	}
+	for _, cp := range d.GetConstituents() {
+		out.Constituents = append(out.Constituents, constituentParseFromProto(cp))
+	}
+	for _, e := range d.GetExpressions() {
+		out.Expressions = append(out.Expressions, expressionFromProto(e))
+	}
+	for _, e := range d.GetEntities() {
+		out.Entities = append(out.Entities, entityFromProto(e))
+	}
+	for _, r := range d.GetRelations() {
+		out.Relations = append(out.Relations, relationFromProto(r))
+	}
+	for _, tr := range d.GetTriples() {
+		out.Triples = append(out.Triples, tripleFromProto(tr))
+	}
+	for _, tx := range d.GetTimexes() {
+		out.Timexes = append(out.Timexes, timexFromProto(tx))
+	}
+	for _, q := range d.GetQuotes() {
+		out.Quotes = append(out.Quotes, quoteFromProto(q))
+	}
+	for _, m := range d.GetMentions() {
+		out.Mentions = append(out.Mentions, nerMentionFromProto(m))
+	}
+	return out
+}
+
+func metaToProto(m Meta) *pb.Meta {
+	return &pb.Meta{
+		DcConformsTo:  m.DCConformsTo,
+		DcAuthor:      m.DCAuthor,
+		DcCreated:     m.DCCreated,
+		DcDate:        m.DCDate,
+		DcSource:      m.DCSource,
+		DcLanguage:    m.DCLanguage,
+		DcCreator:     m.DCCreator,
+		DcPublisher:   m.DCPublisher,
+		DcTitle:       m.DCTitle,
+		DcDescription: m.DCDescription,
+		DcIdentifier:  m.DCIdentifier,
+	}
+}
+
+func metaFromProto(m *pb.Meta) Meta {
+	if m == nil {
+		return Meta{}
+	}
+	return Meta{
+		DCConformsTo:  m.GetDcConformsTo(),
+		DCAuthor:      m.GetDcAuthor(),
+		DCCreated:     m.GetDcCreated(),
+		DCDate:        m.GetDcDate(),
+		DCSource:      m.GetDcSource(),
+		DCLanguage:    m.GetDcLanguage(),
+		DCCreator:     m.GetDcCreator(),
+		DCPublisher:   m.GetDcPublisher(),
+		DCTitle:       m.GetDcTitle(),
+		DCDescription: m.GetDcDescription(),
+		DCIdentifier:  m.GetDcIdentifier(),
+	}
+}
+
+func tokenToProto(t Token) *pb.Token {
+	return &pb.Token{
+		Id:                   int32(t.ID),
+		SentenceId:           int32(t.SentenceID),
+		Text:                 t.Text,
+		Lemma:                t.Lemma,
+		Xpos:                 t.XPoS,
+		XposProb:             t.XPoSProbability,
+		Upos:                 t.UPoS,
+		UposProb:             t.UPoSProbability,
+		EntityIob:            t.EntityIOB,
+		CharacterOffsetBegin: int32(t.CharacterOffsetBegin),
+		CharacterOffsetEnd:   int32(t.CharacterOffsetEnd),
+		PropId:               t.PropID,
+		PropIdProbability:    t.PropIDProbability,
+		FrameId:              int32(t.FrameID),
+		FrameIdProbability:   t.FrameIDProbability,
+		WordNetId:            int32(t.WordNetID),
+		WordNetIdProbability: t.WordNetIDProbability,
+		VerbNetId:            int32(t.VerbNetID),
+		VerbNetIdProbability: t.VerbNetIDProbability,
+		Lang:                 t.Lang,
+		Features:             tokenFeaturesToProto(t.Features),
+		Shape:                t.Shape,
+		Entity:               t.Entity,
+	}
+}
+
+func tokenFromProto(t *pb.Token) Token {
+	return Token{
+		ID:                   int(t.GetId()),
+		SentenceID:           int(t.GetSentenceId()),
+		Text:                 t.GetText(),
+		Lemma:                t.GetLemma(),
+		XPoS:                 t.GetXpos(),
+		XPoSProbability:      t.GetXposProb(),
+		UPoS:                 t.GetUpos(),
+		UPoSProbability:      t.GetUposProb(),
+		EntityIOB:            t.GetEntityIob(),
+		CharacterOffsetBegin: int(t.GetCharacterOffsetBegin()),
+		CharacterOffsetEnd:   int(t.GetCharacterOffsetEnd()),
+		PropID:               t.GetPropId(),
+		PropIDProbability:    t.GetPropIdProbability(),
+		FrameID:              int(t.GetFrameId()),
+		FrameIDProbability:   t.GetFrameIdProbability(),
+		WordNetID:            int(t.GetWordNetId()),
+		WordNetIDProbability: t.GetWordNetIdProbability(),
+		VerbNetID:            int(t.GetVerbNetId()),
+		VerbNetIDProbability: t.GetVerbNetIdProbability(),
+		Lang:                 t.GetLang(),
+		Features:             tokenFeaturesFromProto(t.GetFeatures()),
+		Shape:                t.GetShape(),
+		Entity:               t.GetEntity(),
+	}
+}
+
+func tokenFeaturesToProto(f TokenFeatures) *pb.TokenFeatures {
+	return &pb.TokenFeatures{
+		Overt:          f.Overt,
+		Stop:           f.Stop,
+		Alpha:          f.Alpha,
+		Number:         int32(f.Number),
+		Gender:         f.Gender,
+		Person:         int32(f.Person),
+		Tense:          f.Tense,
+		Perfect:        f.Perfect,
+		Continuous:     f.Continuous,
+		Progressive:    f.Progressive,
+		Case:           f.Case,
+		Human:          f.Human,
+		Animate:        f.Animate,
+		Negated:        f.Negated,
+		Countable:      f.Countable,
+		Factive:        f.Factive,
+		Counterfactive: f.Counterfactive,
+		Irregular:      f.Irregular,
+		PhrasalVerb:    f.PhrasalVerb,
+		Mood:           f.Mood,
+		Foreign:        f.Foreign,
+		SpaceAfter:     f.SpaceAfter,
+		Voice:          f.Voice,
+	}
+}
+
+func tokenFeaturesFromProto(f *pb.TokenFeatures) TokenFeatures {
+	if f == nil {
+		return TokenFeatures{}
+	}
+	return TokenFeatures{
+		Overt:          f.GetOvert(),
+		Stop:           f.GetStop(),
+		Alpha:          f.GetAlpha(),
+		Number:         int(f.GetNumber()),
+		Gender:         f.GetGender(),
+		Person:         int(f.GetPerson()),
+		Tense:          f.GetTense(),
+		Perfect:        f.GetPerfect(),
+		Continuous:     f.GetContinuous(),
+		Progressive:    f.GetProgressive(),
+		Case:           f.GetCase(),
+		Human:          f.GetHuman(),
+		Animate:        f.GetAnimate(),
+		Negated:        f.GetNegated(),
+		Countable:      f.GetCountable(),
+		Factive:        f.GetFactive(),
+		Counterfactive: f.GetCounterfactive(),
+		Irregular:      f.GetIrregular(),
+		PhrasalVerb:    f.GetPhrasalVerb(),
+		Mood:           f.GetMood(),
+		Foreign:        f.GetForeign(),
+		SpaceAfter:     f.GetSpaceAfter(),
+		Voice:          f.GetVoice(),
+	}
+}
+
+func sentenceToProto(s Sentence) *pb.Sentence {
+	return &pb.Sentence{
+		Id:                   int32(s.ID),
+		TokenFrom:            int32(s.TokenFrom),
+		TokenTo:              int32(s.TokenTo),
+		Tokens:               intsToInt32s(s.Tokens),
+		Clauses:              intsToInt32s(s.Clauses),
+		Type:                 s.Type,
+		Sentiment:            s.Sentiment,
+		SentimentProbability: s.SentimentProbability,
+	}
+}
+
+func sentenceFromProto(s *pb.Sentence) Sentence {
+	return Sentence{
+		ID:                   int(s.GetId()),
+		TokenFrom:            int(s.GetTokenFrom()),
+		TokenTo:              int(s.GetTokenTo()),
+		Tokens:               int32sToInts(s.GetTokens()),
+		Clauses:              int32sToInts(s.GetClauses()),
+		Type:                 s.GetType(),
+		Sentiment:            s.GetSentiment(),
+		SentimentProbability: s.GetSentimentProbability(),
+	}
+}
+
+func clauseToProto(c Clause) *pb.Clause {
+	return &pb.Clause{
+		Id:                   int32(c.ID),
+		SentenceId:           int32(c.SentenceID),
+		TokenFrom:            int32(c.TokenFrom),
+		TokenTo:              int32(c.TokenTo),
+		Tokens:               intsToInt32s(c.Tokens),
+		Main:                 c.Main,
+		Governor:             int32(c.Governor),
+		Head:                 int32(c.Head),
+		Negation:             c.Negation,
+		Tense:                c.Tense,
+		Mood:                 c.Mood,
+		Perfect:              c.Perfect,
+		Continuous:           c.Continuous,
+		Aspect:               c.Aspect,
+		Voice:                c.Voice,
+		Sentiment:            c.Sentiment,
+		SentimentProbability: c.SentimentProbability,
+	}
+}
+
+func clauseFromProto(c *pb.Clause) Clause {
+	return Clause{
+		ID:                   int(c.GetId()),
+		SentenceID:           int(c.GetSentenceId()),
+		TokenFrom:            int(c.GetTokenFrom()),
+		TokenTo:              int(c.GetTokenTo()),
+		Tokens:               int32sToInts(c.GetTokens()),
+		Main:                 c.GetMain(),
+		Governor:             int(c.GetGovernor()),
+		Head:                 int(c.GetHead()),
+		Negation:             c.GetNegation(),
+		Tense:                c.GetTense(),
+		Mood:                 c.GetMood(),
+		Perfect:              c.GetPerfect(),
+		Continuous:           c.GetContinuous(),
+		Aspect:               c.GetAspect(),
+		Voice:                c.GetVoice(),
+		Sentiment:            c.GetSentiment(),
+		SentimentProbability: c.GetSentimentProbability(),
+	}
+}
+
+func dependencyToProto(d Dependency) *pb.Dependency {
+	return &pb.Dependency{
+		Label:       d.Label,
+		Governor:    int32(d.Governor),
+		Dependent:   int32(d.Dependent),
+		Probability: d.Probability,
+	}
+}
+
+func dependencyFromProto(d *pb.Dependency) Dependency {
+	return Dependency{
+		Label:       d.GetLabel(),
+		Governor:    int(d.GetGovernor()),
+		Dependent:   int(d.GetDependent()),
+		Probability: d.GetProbability(),
+	}
+}
+
+func dependencyTreeToProto(t DependencyTree) *pb.DependencyTree {
+	out := &pb.DependencyTree{
+		SentenceId:    int32(t.SentenceID),
+		Style:         t.Style,
+		Probability:   t.Probability,
+		HashOverHeads: int32(t.HashOverHeads),
+	}
+	for _, d := range t.Dependencies {
+		out.Dependencies = append(out.Dependencies, dependencyToProto(d))
+	}
+	return out
+}
+
+func dependencyTreeFromProto(t *pb.DependencyTree) DependencyTree {
+	out := DependencyTree{
+		SentenceID:    int(t.GetSentenceId()),
+		Style:         t.GetStyle(),
+		Probability:   t.GetProbability(),
+		HashOverHeads: int(t.GetHashOverHeads()),
+	}
+	for _, d := range t.GetDependencies() {
+		out.Dependencies = append(out.Dependencies, dependencyFromProto(d))
+	}
+	return out
+}
+
+func coreferenceToProto(c Coreference) *pb.Coreference {
+	out := &pb.Coreference{
+		Id: int32(c.ID),
+		Representative: &pb.CoreferenceRepresentative{
+			Tokens: intsToInt32s(c.Representative.Tokens),
+			Head:   int32(c.Representative.Head),
+		},
+	}
+	for _, r := range c.Referents {
+		out.Referents = append(out.Referents, &pb.CoreferenceReferents{
+			Tokens:      intsToInt32s(r.Tokens),
+			Head:        int32(r.Head),
+			Probability: r.Probability,
+		})
+	}
+	return out
+}
+
+func coreferenceFromProto(c *pb.Coreference) Coreference {
+	out := Coreference{ID: int(c.GetId())}
+	if rep := c.GetRepresentative(); rep != nil {
+		out.Representative = CoreferenceRepresentantive{
+			Tokens: int32sToInts(rep.Tokens),
+			Head:   int(rep.Head),
+		}
+	}
+	for _, r := range c.GetReferents() {
+		out.Referents = append(out.Referents, CoreferenceReferents{
+			Tokens:      int32sToInts(r.Tokens),
+			Head:        int(r.Head),
+			Probability: r.Probability,
+		})
+	}
+	return out
+}
+
+func scopeToProto(s Scope) *pb.Scope {
+	return &pb.Scope{
+		Id:         int32(s.ID),
+		Governor:   intsToInt32s(s.Governor),
+		Dependents: intsToInt32s(s.Dependents),
+		Terminals:  intsToInt32s(s.Terminals),
+	}
+}
+
+func scopeFromProto(s *pb.Scope) Scope {
+	return Scope{
+		ID:         int(s.GetId()),
+		Governor:   int32sToInts(s.GetGovernor()),
+		Dependents: int32sToInts(s.GetDependents()),
+		Terminals:  int32sToInts(s.GetTerminals()),
+	}
+}
+
+func constituentParseToProto(c ConstituentParse) *pb.ConstituentParse {
+	out := &pb.ConstituentParse{
+		SentenceId:        int32(c.SentenceID),
+		Type:              c.Type,
+		LabeledBracketing: c.LabeledBracketing,
+		Probability:       c.Probability,
+	}
+	for _, s := range c.Scopes {
+		out.Scopes = append(out.Scopes, scopeToProto(s))
+	}
+	return out
+}
+
+func constituentParseFromProto(c *pb.ConstituentParse) ConstituentParse {
+	out := ConstituentParse{
+		SentenceID:        int(c.GetSentenceId()),
+		Type:              c.GetType(),
+		LabeledBracketing: c.GetLabeledBracketing(),
+		Probability:       c.GetProbability(),
+	}
+	for _, s := range c.GetScopes() {
+		out.Scopes = append(out.Scopes, scopeFromProto(s))
+	}
+	return out
+}
+
+func expressionToProto(e Expression) *pb.Expression {
+	return &pb.Expression{
+		Id:          int32(e.ID),
+		Type:        e.Type,
+		Head:        int32(e.Head),
+		Dependency:  e.Dependency,
+		TokenFrom:   int32(e.TokenFrom),
+		TokenTo:     int32(e.TokenTo),
+		Tokens:      intsToInt32s(e.Tokens),
+		Probability: e.Probability,
+	}
+}
+
+func expressionFromProto(e *pb.Expression) Expression {
+	return Expression{
+		ID:          int(e.GetId()),
+		Type:        e.GetType(),
+		Head:        int(e.GetHead()),
+		Dependency:  e.GetDependency(),
+		TokenFrom:   int(e.GetTokenFrom()),
+		TokenTo:     int(e.GetTokenTo()),
+		Tokens:      int32sToInts(e.GetTokens()),
+		Probability: e.GetProbability(),
+	}
+}
+
+func paragraphToProto(p Paragraph) *pb.Paragraph {
+	return &pb.Paragraph{
+		Id:        int32(p.ID),
+		TokenFrom: int32(p.TokenFrom),
+		TokenTo:   int32(p.TokenTo),
+		Tokens:    intsToInt32s(p.Tokens),
+		Sentences: intsToInt32s(p.Sentences),
+	}
+}
+
+func paragraphFromProto(p *pb.Paragraph) Paragraph {
+	return Paragraph{
+		ID:        int(p.GetId()),
+		TokenFrom: int(p.GetTokenFrom()),
+		TokenTo:   int(p.GetTokenTo()),
+		Tokens:    int32sToInts(p.GetTokens()),
+		Sentences: int32sToInts(p.GetSentences()),
+	}
+}
+
+func attributeToProto(a Attribute) *pb.Attribute {
+	return &pb.Attribute{Label: a.Label, Value: a.Value}
+}
+
+func attributeFromProto(a *pb.Attribute) Attribute {
+	return Attribute{Label: a.GetLabel(), Value: a.GetValue()}
+}
+
+func entityToProto(e Entity) *pb.Entity {
+	out := &pb.Entity{
+		Id:                   int32(e.ID),
+		Label:                e.Label,
+		Type:                 e.Type,
+		Url:                  e.URL,
+		Head:                 int32(e.Head),
+		TokenFrom:            int32(e.TokenFrom),
+		TokenTo:              int32(e.TokenTo),
+		Tokens:               intsToInt32s(e.Tokens),
+		TripleId:             int32(e.TripleID),
+		Sentiment:            e.Sentiment,
+		SentimentProbability: e.SentimentProbability,
+		Count:                int32(e.Count),
+	}
+	for _, a := range e.Attributes {
+		out.Attributes = append(out.Attributes, attributeToProto(a))
+	}
+	return out
+}
+
+func entityFromProto(e *pb.Entity) Entity {
+	out := Entity{
+		ID:                   int(e.GetId()),
+		Label:                e.GetLabel(),
+		Type:                 e.GetType(),
+		URL:                  e.GetUrl(),
+		Head:                 int(e.GetHead()),
+		TokenFrom:            int(e.GetTokenFrom()),
+		TokenTo:              int(e.GetTokenTo()),
+		Tokens:               int32sToInts(e.GetTokens()),
+		TripleID:             int(e.GetTripleId()),
+		Sentiment:            e.GetSentiment(),
+		SentimentProbability: e.GetSentimentProbability(),
+		Count:                int(e.GetCount()),
+	}
+	for _, a := range e.GetAttributes() {
+		out.Attributes = append(out.Attributes, attributeFromProto(a))
+	}
+	return out
+}
+
+func relationToProto(r Relation) *pb.Relation {
+	out := &pb.Relation{
+		Id:                   int32(r.ID),
+		Label:                r.Label,
+		Type:                 r.Type,
+		Url:                  r.URL,
+		Head:                 int32(r.Head),
+		TokenFrom:            int32(r.TokenFrom),
+		TokenTo:              int32(r.TokenTo),
+		Tokens:               intsToInt32s(r.Tokens),
+		Sentiment:            r.Sentiment,
+		SentimentProbability: r.SentimentProbability,
+		Count:                int32(r.Count),
+	}
+	for _, a := range r.Attributes {
+		out.Attributes = append(out.Attributes, attributeToProto(a))
+	}
+	return out
+}
+
+func relationFromProto(r *pb.Relation) Relation {
+	out := Relation{
+		ID:                   int(r.GetId()),
+		Label:                r.GetLabel(),
+		Type:                 r.GetType(),
+		URL:                  r.GetUrl(),
+		Head:                 int(r.GetHead()),
+		TokenFrom:            int(r.GetTokenFrom()),
+		TokenTo:              int(r.GetTokenTo()),
+		Tokens:               int32sToInts(r.GetTokens()),
+		Sentiment:            r.GetSentiment(),
+		SentimentProbability: r.GetSentimentProbability(),
+		Count:                int(r.GetCount()),
+	}
+	for _, a := range r.GetAttributes() {
+		out.Attributes = append(out.Attributes, attributeFromProto(a))
+	}
+	return out
+}
+
+func tripleToProto(t Triple) *pb.Triple {
+	return &pb.Triple{
+		Id:               int32(t.ID),
+		FromEntity:       int32(t.FromEntity),
+		ToEntity:         int32(t.ToEntity),
+		Relation:         int32(t.Relation),
+		ClauseId:         intsToInt32s(t.ClauseID),
+		SentenceId:       intsToInt32s(t.SentenceID),
+		Directional:      t.Directional,
+		EventId:          int32(t.EventID),
+		TemporalSequence: int32(t.TemporalSequence),
+		Probability:      t.Probability,
+		Syntactic:        t.Syntactic,
+		Implied:          t.Implied,
+		Presupposed:      t.Presupposed,
+		Count:            int32(t.Count),
+	}
+}
+
+func tripleFromProto(t *pb.Triple) Triple {
+	return Triple{
+		ID:               int(t.GetId()),
+		FromEntity:       int(t.GetFromEntity()),
+		ToEntity:         int(t.GetToEntity()),
+		Relation:         int(t.GetRelation()),
+		ClauseID:         int32sToInts(t.GetClauseId()),
+		SentenceID:       int32sToInts(t.GetSentenceId()),
+		Directional:      t.GetDirectional(),
+		EventID:          int(t.GetEventId()),
+		TemporalSequence: int(t.GetTemporalSequence()),
+		Probability:      t.GetProbability(),
+		Syntactic:        t.GetSyntactic(),
+		Implied:          t.GetImplied(),
+		Presupposed:      t.GetPresupposed(),
+		Count:            int(t.GetCount()),
+	}
+}
+
+func timexToProto(t Timex) *pb.Timex {
+	return &pb.Timex{
+		Tid:       t.TID,
+		Type:      t.Type,
+		Value:     t.Value,
+		AltValue:  t.AltValue,
+		TokenFrom: int32(t.TokenFrom),
+		TokenTo:   int32(t.TokenTo),
+	}
+}
+
+func timexFromProto(t *pb.Timex) Timex {
+	return Timex{
+		TID:       t.GetTid(),
+		Type:      t.GetType(),
+		Value:     t.GetValue(),
+		AltValue:  t.GetAltValue(),
+		TokenFrom: int(t.GetTokenFrom()),
+		TokenTo:   int(t.GetTokenTo()),
+	}
+}
+
+func quoteToProto(q Quote) *pb.Quote {
+	return &pb.Quote{
+		Id:          int32(q.ID),
+		Speaker:     q.Speaker,
+		Mention:     q.Mention,
+		Begin:       int32(q.Begin),
+		End:         int32(q.End),
+		SentenceIds: intsToInt32s(q.SentenceIDs),
+	}
+}
+
+func quoteFromProto(q *pb.Quote) Quote {
+	return Quote{
+		ID:          int(q.GetId()),
+		Speaker:     q.GetSpeaker(),
+		Mention:     q.GetMention(),
+		Begin:       int(q.GetBegin()),
+		End:         int(q.GetEnd()),
+		SentenceIDs: int32sToInts(q.GetSentenceIds()),
+	}
+}
+
+func nerMentionToProto(m NERMention) *pb.NERMention {
+	return &pb.NERMention{
+		Id:              int32(m.ID),
+		TokenFrom:       int32(m.TokenFrom),
+		TokenTo:         int32(m.TokenTo),
+		Text:            m.Text,
+		Entity:          m.Entity,
+		NormalizedValue: m.NormalizedValue,
+		Gender:          m.Gender,
+		EntityLink:      m.EntityLink,
+	}
+}
+
+func nerMentionFromProto(m *pb.NERMention) NERMention {
+	return NERMention{
+		ID:              int(m.GetId()),
+		TokenFrom:       int(m.GetTokenFrom()),
+		TokenTo:         int(m.GetTokenTo()),
+		Text:            m.GetText(),
+		Entity:          m.GetEntity(),
+		NormalizedValue: m.GetNormalizedValue(),
+		Gender:          m.GetGender(),
+		EntityLink:      m.GetEntityLink(),
+	}
+}
+
+func intsToInt32s(in []int) []int32 {
+	if in == nil {
+		return nil
+	}
+	out := make([]int32, len(in))
+	for i, v := range in {
+		out[i] = int32(v)
+	}
+	return out
+}
+
+func int32sToInts(in []int32) []int {
+	if in == nil {
+		return nil
+	}
+	out := make([]int, len(in))
+	for i, v := range in {
+		out[i] = int(v)
+	}
+	return out
+}
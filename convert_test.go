@@ -0,0 +1,122 @@
+package jsonnlp
+
+import (
+	"testing"
+
+	"github.com/SemiringInc/jsonnlp/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+// TestToFromProtoRoundTrip verifies that a Document carrying one of each
+// kind of annotation, including the Timex/Quote/NERMention fields chunk0-6
+// added, survives a ToProto/FromProto round trip unchanged.
+func TestToFromProtoRoundTrip(t *testing.T) {
+	doc := &Document{
+		MetaDocument: Meta{DCTitle: "sample"},
+		ID:           1,
+		TokenList: []Token{
+			{ID: 1, SentenceID: 1, Text: "Alice", Features: TokenFeatures{Voice: "active"}},
+		},
+		Sentences: []Sentence{
+			{ID: 1, TokenFrom: 1, TokenTo: 1, Tokens: []int{1}},
+		},
+		Entities: []Entity{
+			{ID: 1, Label: "Alice", Type: "PERSON", TokenFrom: 1, TokenTo: 1},
+		},
+		Timexes: []Timex{
+			{TID: "t1", Type: "DATE", Value: "2020-05-28", TokenFrom: 1, TokenTo: 1},
+		},
+		Quotes: []Quote{
+			{ID: 1, Speaker: "Alice", Mention: "hello", SentenceIDs: []int{1}},
+		},
+		Mentions: []NERMention{
+			{ID: 1, TokenFrom: 1, TokenTo: 1, Text: "Alice", Entity: "PERSON"},
+		},
+	}
+
+	got := FromProto(ToProto(doc))
+
+	if got.MetaDocument.DCTitle != doc.MetaDocument.DCTitle {
+		t.Errorf("meta: got %q, want %q", got.MetaDocument.DCTitle, doc.MetaDocument.DCTitle)
+	}
+	if len(got.TokenList) != 1 || got.TokenList[0].Text != "Alice" || got.TokenList[0].Features.Voice != "active" {
+		t.Errorf("tokenList: got %+v", got.TokenList)
+	}
+	if len(got.Timexes) != 1 || got.Timexes[0] != doc.Timexes[0] {
+		t.Errorf("timexes: got %+v, want %+v", got.Timexes, doc.Timexes)
+	}
+	if len(got.Quotes) != 1 || got.Quotes[0].Speaker != "Alice" || got.Quotes[0].Mention != "hello" {
+		t.Errorf("quotes: got %+v", got.Quotes)
+	}
+	if len(got.Mentions) != 1 || got.Mentions[0].Text != "Alice" || got.Mentions[0].Entity != "PERSON" {
+		t.Errorf("mentions: got %+v", got.Mentions)
+	}
+}
+
+// TestToFromProtoWireRoundTrip verifies that a Document survives an actual
+// proto.Marshal/proto.Unmarshal over the wire, not just an in-memory
+// ToProto/FromProto call, since pb/jsonnlp.pb.go was hand-authored to mimic
+// protoc-gen-go output rather than generated by it.
+func TestToFromProtoWireRoundTrip(t *testing.T) {
+	doc := &Document{
+		MetaDocument: Meta{DCTitle: "sample"},
+		ID:           1,
+		TokenList: []Token{
+			{ID: 1, SentenceID: 1, Text: "Alice", Features: TokenFeatures{Voice: "active"}},
+		},
+		Sentences: []Sentence{
+			{ID: 1, TokenFrom: 1, TokenTo: 1, Tokens: []int{1}},
+		},
+		Entities: []Entity{
+			{ID: 1, Label: "Alice", Type: "PERSON", TokenFrom: 1, TokenTo: 1},
+		},
+		Timexes: []Timex{
+			{TID: "t1", Type: "DATE", Value: "2020-05-28", TokenFrom: 1, TokenTo: 1},
+		},
+		Quotes: []Quote{
+			{ID: 1, Speaker: "Alice", Mention: "hello", SentenceIDs: []int{1}},
+		},
+		Mentions: []NERMention{
+			{ID: 1, TokenFrom: 1, TokenTo: 1, Text: "Alice", Entity: "PERSON"},
+		},
+	}
+
+	wire, err := proto.Marshal(ToProto(doc))
+	if err != nil {
+		t.Fatalf("proto.Marshal: %v", err)
+	}
+
+	var pbDoc pb.Document
+	if err := proto.Unmarshal(wire, &pbDoc); err != nil {
+		t.Fatalf("proto.Unmarshal: %v", err)
+	}
+
+	got := FromProto(&pbDoc)
+
+	if got.MetaDocument.DCTitle != doc.MetaDocument.DCTitle {
+		t.Errorf("meta: got %q, want %q", got.MetaDocument.DCTitle, doc.MetaDocument.DCTitle)
+	}
+	if len(got.TokenList) != 1 || got.TokenList[0].Text != "Alice" || got.TokenList[0].Features.Voice != "active" {
+		t.Errorf("tokenList: got %+v", got.TokenList)
+	}
+	if len(got.Timexes) != 1 || got.Timexes[0] != doc.Timexes[0] {
+		t.Errorf("timexes: got %+v, want %+v", got.Timexes, doc.Timexes)
+	}
+	if len(got.Quotes) != 1 || got.Quotes[0].Speaker != "Alice" || got.Quotes[0].Mention != "hello" {
+		t.Errorf("quotes: got %+v", got.Quotes)
+	}
+	if len(got.Mentions) != 1 || got.Mentions[0].Text != "Alice" || got.Mentions[0].Entity != "PERSON" {
+		t.Errorf("mentions: got %+v", got.Mentions)
+	}
+}
+
+// TestToProtoNil verifies ToProto/FromProto are nil-safe, matching the
+// nil-in-nil-out convention the rest of the package follows.
+func TestToProtoNil(t *testing.T) {
+	if got := ToProto(nil); got != nil {
+		t.Errorf("ToProto(nil) = %v, want nil", got)
+	}
+	if got := FromProto(nil); got != nil {
+		t.Errorf("FromProto(nil) = %v, want nil", got)
+	}
+}
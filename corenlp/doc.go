@@ -0,0 +1,14 @@
+/**
+ * JSONNLP package
+ * (C) 2020 by Semiring Inc., Damir Cavar
+ *
+ * Package corenlp converts Stanford CoreNLP output into JSON-NLP.
+ *
+ * It reads either the CoreNLP XML output (-outputFormat xml) or the CoreNLP
+ * protobuf output (-outputFormat serialized with
+ * edu.stanford.nlp.pipeline.ProtobufAnnotationSerializer) and maps sentences,
+ * tokens, dependencies, coreference chains, named entity mentions, and TIMEX3
+ * expressions onto the corresponding *jsonnlp.JSONNLP fields.
+ */
+
+package corenlp // import "github.com/SemiringInc/jsonnlp/corenlp"
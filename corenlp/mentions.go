@@ -0,0 +1,58 @@
+package corenlp
+
+import "github.com/SemiringInc/jsonnlp"
+
+// nerToken is the minimal per-token information mentionsFromTokens needs to
+// group consecutive same-type NER tags into entity mentions; both the XML
+// and protobuf adapters reduce their native token representation to this
+// before calling it.
+type nerToken struct {
+	id         int
+	text       string
+	ner        string
+	normalized string
+}
+
+// mentionsFromTokens groups consecutive tokens carrying the same non-"O"
+// NER tag into NERMention spans, in token order.
+func mentionsFromTokens(tokens []nerToken) []jsonnlp.NERMention {
+	var mentions []jsonnlp.NERMention
+	var current *jsonnlp.NERMention
+	var text string
+
+	flush := func() {
+		if current != nil {
+			current.Text = text
+			mentions = append(mentions, *current)
+			current = nil
+			text = ""
+		}
+	}
+
+	for _, t := range tokens {
+		if t.ner == "" || t.ner == "O" {
+			flush()
+			continue
+		}
+		if current != nil && current.Entity == t.ner {
+			current.TokenTo = t.id
+			text += " " + t.text
+			if t.normalized != "" {
+				current.NormalizedValue = t.normalized
+			}
+			continue
+		}
+		flush()
+		current = &jsonnlp.NERMention{
+			ID:              len(mentions) + 1,
+			TokenFrom:       t.id,
+			TokenTo:         t.id,
+			Entity:          t.ner,
+			NormalizedValue: t.normalized,
+		}
+		text = t.text
+	}
+	flush()
+
+	return mentions
+}
@@ -0,0 +1,136 @@
+package corenlp
+
+import (
+	"strings"
+
+	"github.com/SemiringInc/jsonnlp"
+	"github.com/SemiringInc/jsonnlp/corenlp/corenlppb"
+	"github.com/golang/protobuf/proto"
+)
+
+// FromCoreNLPProto reads the serialized edu.stanford.nlp.pipeline.Document
+// protobuf message that CoreNLP writes with -outputFormat serialized and
+// converts it into a populated JSONNLP instance.
+func FromCoreNLPProto(b []byte) (*jsonnlp.JSONNLP, error) {
+	var pbDoc corenlppb.Document
+	if err := proto.Unmarshal(b, &pbDoc); err != nil {
+		return nil, err
+	}
+
+	data := &jsonnlp.JSONNLP{}
+	doc := jsonnlp.Document{ID: 1}
+
+	tokenID := 1
+	sentenceFirstToken := map[int]int{}
+	var nerTokens []nerToken
+	for sIdx, s := range pbDoc.Sentence {
+		sentenceID := sIdx + 1
+		sentenceFirstToken[sentenceID] = tokenID
+		sentence := jsonnlp.Sentence{ID: sentenceID}
+		prevNER := ""
+		for _, t := range s.Token {
+			iob := ""
+			if t.GetNer() != "" && t.GetNer() != "O" {
+				if t.GetNer() == prevNER {
+					iob = "I"
+				} else {
+					iob = "B"
+				}
+			}
+			prevNER = t.GetNer()
+			doc.TokenList = append(doc.TokenList, jsonnlp.Token{
+				ID:                   tokenID,
+				SentenceID:           sentenceID,
+				Text:                 t.GetWord(),
+				Lemma:                t.GetLemma(),
+				XPoS:                 t.GetPos(),
+				Entity:               t.GetNer(),
+				EntityIOB:            iob,
+				CharacterOffsetBegin: int(t.GetBeginChar()),
+				CharacterOffsetEnd:   int(t.GetEndChar()),
+			})
+			nerTokens = append(nerTokens, nerToken{id: tokenID, text: t.GetWord(), ner: t.GetNer(), normalized: t.GetNormalizedNer()})
+			sentence.Tokens = append(sentence.Tokens, tokenID)
+			tokenID++
+		}
+		if len(sentence.Tokens) > 0 {
+			sentence.TokenFrom = sentence.Tokens[0]
+			sentence.TokenTo = sentence.Tokens[len(sentence.Tokens)-1]
+		}
+		doc.Sentences = append(doc.Sentences, sentence)
+
+		if graph := s.GetBasicDependencies(); graph != nil {
+			tree := jsonnlp.DependencyTree{SentenceID: sentenceID, Style: "basic-dependencies"}
+			for _, e := range graph.GetEdge() {
+				tree.Dependencies = append(tree.Dependencies, jsonnlp.Dependency{
+					Label:     e.GetDep(),
+					Governor:  sentenceFirstToken[sentenceID] + int(e.GetSource()) - 1,
+					Dependent: sentenceFirstToken[sentenceID] + int(e.GetTarget()) - 1,
+				})
+			}
+			doc.DependencyTrees = append(doc.DependencyTrees, tree)
+		}
+	}
+
+	for i, chain := range pbDoc.CorefChain {
+		coref := jsonnlp.Coreference{ID: i + 1}
+		for idx, m := range chain.GetMention() {
+			sentenceID := int(m.GetSentenceIndex()) + 1
+			tokenFrom := sentenceFirstToken[sentenceID] + int(m.GetBeginIndex())
+			tokenTo := sentenceFirstToken[sentenceID] + int(m.GetEndIndex()) - 1
+			head := sentenceFirstToken[sentenceID] + int(m.GetHeadIndex())
+			tokens := make([]int, 0, tokenTo-tokenFrom+1)
+			for id := tokenFrom; id <= tokenTo; id++ {
+				tokens = append(tokens, id)
+			}
+			if int32(idx) == chain.GetRepresentative() {
+				coref.Representative = jsonnlp.CoreferenceRepresentantive{Tokens: tokens, Head: head}
+			} else {
+				coref.Referents = append(coref.Referents, jsonnlp.CoreferenceReferents{Tokens: tokens, Head: head})
+			}
+		}
+		doc.Coreferences = append(doc.Coreferences, coref)
+	}
+
+	if mentions := pbDoc.GetMentions(); len(mentions) > 0 {
+		for i, m := range mentions {
+			sentenceID := int(m.GetSentenceIndex()) + 1
+			tokenFrom := sentenceFirstToken[sentenceID] + int(m.GetTokenStartInSentenceInclusive())
+			tokenTo := sentenceFirstToken[sentenceID] + int(m.GetTokenEndInSentenceExclusive()) - 1
+			var words []string
+			for id := tokenFrom; id <= tokenTo && id-1 < len(doc.TokenList); id++ {
+				words = append(words, doc.TokenList[id-1].Text)
+			}
+			doc.Mentions = append(doc.Mentions, jsonnlp.NERMention{
+				ID:              i + 1,
+				TokenFrom:       tokenFrom,
+				TokenTo:         tokenTo,
+				Text:            strings.Join(words, " "),
+				Entity:          m.GetNer(),
+				NormalizedValue: m.GetNormalizedNer(),
+				Gender:          m.GetGender(),
+			})
+		}
+	} else {
+		// Older CoreNLP protobuf payloads don't carry NERMention at all;
+		// fall back to grouping the per-token NER tags instead.
+		doc.Mentions = mentionsFromTokens(nerTokens)
+	}
+
+	for _, q := range pbDoc.GetQuote() {
+		quote := jsonnlp.Quote{
+			ID:      int(q.GetIndex()) + 1,
+			Speaker: q.GetSpeaker(),
+			Mention: q.GetText(),
+			Begin:   int(q.GetTokenBegin()) + 1,
+			End:     int(q.GetTokenEnd()),
+		}
+		for sid := int(q.GetSentenceBegin()) + 1; sid <= int(q.GetSentenceEnd()); sid++ {
+			quote.SentenceIDs = append(quote.SentenceIDs, sid)
+		}
+		doc.Quotes = append(doc.Quotes, quote)
+	}
+
+	data.Documents = append(data.Documents, doc)
+	return data, nil
+}
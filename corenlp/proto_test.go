@@ -0,0 +1,137 @@
+package corenlp
+
+import (
+	"testing"
+
+	"github.com/SemiringInc/jsonnlp/corenlp/corenlppb"
+	"github.com/golang/protobuf/proto"
+)
+
+func pstr(s string) *string { return &s }
+func pi32(n int32) *int32   { return &n }
+
+// corefNLPProtoDoc is a two-sentence CoreNLP protobuf Document covering a
+// dependency edge, a CorefChain with a non-zero representative index, the
+// NERMention list chunk0-1 maps directly (rather than falling back to
+// mentionsFromTokens), and a Quote spanning both sentences so the
+// Begin/End off-by-one fixed in chunk0-6 would be caught here too.
+func corefNLPProtoDoc() *corenlppb.Document {
+	return &corenlppb.Document{
+		Sentence: []*corenlppb.Sentence{
+			{
+				Token: []*corenlppb.Token{
+					{Word: pstr("Alice"), Lemma: pstr("Alice"), Pos: pstr("NNP"), Ner: pstr("PERSON"), BeginChar: pi32(0), EndChar: pi32(5)},
+					{Word: pstr("said"), Lemma: pstr("say"), Pos: pstr("VBD"), Ner: pstr("O"), BeginChar: pi32(6), EndChar: pi32(10)},
+					{Word: pstr(","), Lemma: pstr(","), Pos: pstr(","), Ner: pstr("O"), BeginChar: pi32(10), EndChar: pi32(11)},
+				},
+				BasicDependencies: &corenlppb.DependencyGraph{
+					Edge: []*corenlppb.DependencyGraph_Edge{
+						{Source: pi32(2), Target: pi32(1), Dep: pstr("nsubj")},
+					},
+				},
+			},
+			{
+				Token: []*corenlppb.Token{
+					{Word: pstr("Hello"), Lemma: pstr("hello"), Pos: pstr("UH"), Ner: pstr("O"), BeginChar: pi32(12), EndChar: pi32(17)},
+					{Word: pstr("World"), Lemma: pstr("World"), Pos: pstr("NNP"), Ner: pstr("LOCATION"), BeginChar: pi32(18), EndChar: pi32(23)},
+					{Word: pstr("!"), Lemma: pstr("!"), Pos: pstr("."), Ner: pstr("O"), BeginChar: pi32(23), EndChar: pi32(24)},
+				},
+			},
+		},
+		Mentions: []*corenlppb.NERMention{
+			{SentenceIndex: pi32(0), TokenStartInSentenceInclusive: pi32(0), TokenEndInSentenceExclusive: pi32(1), Ner: pstr("PERSON")},
+			{SentenceIndex: pi32(1), TokenStartInSentenceInclusive: pi32(1), TokenEndInSentenceExclusive: pi32(2), Ner: pstr("LOCATION")},
+		},
+		CorefChain: []*corenlppb.CorefChain{
+			{
+				ChainID:        pi32(1),
+				Representative: pi32(1),
+				Mention: []*corenlppb.CorefChain_CorefMention{
+					{MentionID: pi32(0), SentenceIndex: pi32(0), BeginIndex: pi32(0), EndIndex: pi32(1), HeadIndex: pi32(0)},
+					{MentionID: pi32(1), SentenceIndex: pi32(1), BeginIndex: pi32(1), EndIndex: pi32(2), HeadIndex: pi32(1)},
+				},
+			},
+		},
+		Quote: []*corenlppb.Quote{
+			{
+				Index:         pi32(0),
+				Text:          pstr("said, Hello World"),
+				Speaker:       pstr("Alice"),
+				TokenBegin:    pi32(1),
+				TokenEnd:      pi32(5),
+				SentenceBegin: pi32(0),
+				SentenceEnd:   pi32(2),
+			},
+		},
+	}
+}
+
+// TestFromCoreNLPProtoRoundTrip verifies that FromCoreNLPProto, given the
+// serialized bytes of a real edu.stanford.nlp.pipeline.Document, assigns
+// per-sentence token ranges and dependencies, maps the NERMention list
+// directly instead of falling back to mentionsFromTokens, resolves the
+// CorefChain representative by mention index rather than mentionID, and
+// converts Quote's document-wide token/sentence offsets correctly.
+func TestFromCoreNLPProtoRoundTrip(t *testing.T) {
+	wire, err := proto.Marshal(corefNLPProtoDoc())
+	if err != nil {
+		t.Fatalf("proto.Marshal: %v", err)
+	}
+
+	data, err := FromCoreNLPProto(wire)
+	if err != nil {
+		t.Fatalf("FromCoreNLPProto: %v", err)
+	}
+	if len(data.Documents) != 1 {
+		t.Fatalf("got %d documents, want 1", len(data.Documents))
+	}
+	doc := data.Documents[0]
+
+	if len(doc.TokenList) != 6 {
+		t.Fatalf("got %d tokens, want 6", len(doc.TokenList))
+	}
+	if len(doc.Sentences) != 2 {
+		t.Fatalf("got %d sentences, want 2", len(doc.Sentences))
+	}
+	if s := doc.Sentences[0]; s.TokenFrom != 1 || s.TokenTo != 3 {
+		t.Errorf("sentence 1 span = [%d,%d], want [1,3]", s.TokenFrom, s.TokenTo)
+	}
+	if s := doc.Sentences[1]; s.TokenFrom != 4 || s.TokenTo != 6 {
+		t.Errorf("sentence 2 span = [%d,%d], want [4,6]", s.TokenFrom, s.TokenTo)
+	}
+
+	if len(doc.DependencyTrees) != 1 {
+		t.Fatalf("got %d dependency trees, want 1", len(doc.DependencyTrees))
+	}
+	if tree := doc.DependencyTrees[0]; len(tree.Dependencies) != 1 || tree.Dependencies[0].Governor != 2 || tree.Dependencies[0].Dependent != 1 || tree.Dependencies[0].Label != "nsubj" {
+		t.Errorf("unexpected dependency tree: %+v", tree)
+	}
+
+	if len(doc.Mentions) != 2 {
+		t.Fatalf("got %d mentions, want 2 (from the NERMention list, not the per-token fallback)", len(doc.Mentions))
+	}
+	if m := doc.Mentions[0]; m.Entity != "PERSON" || m.Text != "Alice" || m.TokenFrom != 1 || m.TokenTo != 1 {
+		t.Errorf("unexpected PERSON mention: %+v", m)
+	}
+	if m := doc.Mentions[1]; m.Entity != "LOCATION" || m.Text != "World" || m.TokenFrom != 5 || m.TokenTo != 5 {
+		t.Errorf("unexpected LOCATION mention: %+v", m)
+	}
+
+	if len(doc.Coreferences) != 1 {
+		t.Fatalf("got %d coreferences, want 1", len(doc.Coreferences))
+	}
+	coref := doc.Coreferences[0]
+	if coref.Representative.Head != 5 || len(coref.Representative.Tokens) != 1 || coref.Representative.Tokens[0] != 5 {
+		t.Errorf("unexpected representative (want mention index 1, not mentionID 1): %+v", coref.Representative)
+	}
+	if len(coref.Referents) != 1 || coref.Referents[0].Head != 1 || coref.Referents[0].Tokens[0] != 1 {
+		t.Errorf("unexpected referents: %+v", coref.Referents)
+	}
+
+	if len(doc.Quotes) != 1 {
+		t.Fatalf("got %d quotes, want 1", len(doc.Quotes))
+	}
+	if q := doc.Quotes[0]; q.Speaker != "Alice" || q.Begin != 2 || q.End != 5 || len(q.SentenceIDs) != 2 || q.SentenceIDs[0] != 1 || q.SentenceIDs[1] != 2 {
+		t.Errorf("unexpected quote: %+v", q)
+	}
+}
@@ -0,0 +1,224 @@
+package corenlp
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/SemiringInc/jsonnlp"
+)
+
+// xmlRoot mirrors the top-level element CoreNLP emits with -outputFormat xml.
+type xmlRoot struct {
+	XMLName  xml.Name    `xml:"root"`
+	Document xmlDocument `xml:"document"`
+}
+
+type xmlDocument struct {
+	Sentences    []xmlSentence    `xml:"sentences>sentence"`
+	Coreferences []xmlCoreference `xml:"coreference>coreference"`
+	Quotes       []xmlQuote       `xml:"quotes>quote"`
+}
+
+type xmlSentence struct {
+	ID           int           `xml:"id,attr"`
+	Tokens       []xmlToken    `xml:"tokens>token"`
+	Dependencies []xmlDepGraph `xml:"dependencies"`
+}
+
+type xmlToken struct {
+	ID                   int      `xml:"id,attr"`
+	Word                 string   `xml:"word"`
+	Lemma                string   `xml:"lemma"`
+	CharacterOffsetBegin int      `xml:"CharacterOffsetBegin"`
+	CharacterOffsetEnd   int      `xml:"CharacterOffsetEnd"`
+	POS                  string   `xml:"POS"`
+	NER                  string   `xml:"NER"`
+	NormalizedNER        string   `xml:"NormalizedNER"`
+	Timex                xmlTimex `xml:"Timex"`
+}
+
+type xmlTimex struct {
+	TID      string `xml:"tid,attr"`
+	Type     string `xml:"type,attr"`
+	AltValue string `xml:"altValue,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type xmlQuote struct {
+	ID            int    `xml:"id,attr"`
+	Text          string `xml:"text"`
+	Begin         int    `xml:"begin"`
+	End           int    `xml:"end"`
+	SentenceBegin int    `xml:"sentenceBegin"`
+	SentenceEnd   int    `xml:"sentenceEnd"`
+	Speaker       string `xml:"speaker"`
+}
+
+type xmlDepGraph struct {
+	Type  string   `xml:"type,attr"`
+	Edges []xmlDep `xml:"dep"`
+}
+
+type xmlDep struct {
+	Type      string       `xml:"type,attr"`
+	Governor  xmlDepTarget `xml:"governor"`
+	Dependent xmlDepTarget `xml:"dependent"`
+}
+
+type xmlDepTarget struct {
+	Idx  int    `xml:"idx,attr"`
+	Text string `xml:",chardata"`
+}
+
+type xmlCoreference struct {
+	Mentions []xmlMention `xml:"mention"`
+}
+
+type xmlMention struct {
+	Representative bool   `xml:"representative,attr"`
+	Sentence       int    `xml:"sentence"`
+	Start          int    `xml:"start"`
+	End            int    `xml:"end"`
+	Head           int    `xml:"head"`
+	Text           string `xml:"text"`
+}
+
+// basicDependencies is the CoreNLP dependency graph type preferred when a
+// sentence carries more than one (basic, collapsed, enhanced, ...).
+const basicDependencies = "basic-dependencies"
+
+// FromCoreNLPXML reads a CoreNLP XML annotation (-outputFormat xml) and
+// converts it into a populated JSONNLP instance.
+func FromCoreNLPXML(r io.Reader) (*jsonnlp.JSONNLP, error) {
+	var root xmlRoot
+	dec := xml.NewDecoder(r)
+	if err := dec.Decode(&root); err != nil {
+		return nil, err
+	}
+
+	data := &jsonnlp.JSONNLP{}
+	doc := jsonnlp.Document{ID: 1}
+
+	tokenID := 1
+	sentenceFirstToken := map[int]int{}
+	timexes := map[string]*jsonnlp.Timex{}
+	var timexOrder []string
+	var nerTokens []nerToken
+	for _, s := range root.Document.Sentences {
+		sentence := jsonnlp.Sentence{ID: s.ID}
+		sentenceFirstToken[s.ID] = tokenID
+		prevNER := ""
+		for _, t := range s.Tokens {
+			iob := ""
+			if t.NER != "" && t.NER != "O" {
+				if t.NER == prevNER {
+					iob = "I"
+				} else {
+					iob = "B"
+				}
+			}
+			prevNER = t.NER
+			doc.TokenList = append(doc.TokenList, jsonnlp.Token{
+				ID:                   tokenID,
+				SentenceID:           s.ID,
+				Text:                 t.Word,
+				Lemma:                t.Lemma,
+				XPoS:                 t.POS,
+				Entity:               t.NER,
+				EntityIOB:            iob,
+				CharacterOffsetBegin: t.CharacterOffsetBegin,
+				CharacterOffsetEnd:   t.CharacterOffsetEnd,
+			})
+			nerTokens = append(nerTokens, nerToken{id: tokenID, text: t.Word, ner: t.NER, normalized: t.NormalizedNER})
+
+			if t.Timex.TID != "" {
+				tx, ok := timexes[t.Timex.TID]
+				if !ok {
+					tx = &jsonnlp.Timex{
+						TID:       t.Timex.TID,
+						Type:      t.Timex.Type,
+						Value:     t.Timex.Value,
+						AltValue:  t.Timex.AltValue,
+						TokenFrom: tokenID,
+					}
+					timexes[t.Timex.TID] = tx
+					timexOrder = append(timexOrder, t.Timex.TID)
+				}
+				tx.TokenTo = tokenID
+			}
+
+			sentence.Tokens = append(sentence.Tokens, tokenID)
+			tokenID++
+		}
+		if len(sentence.Tokens) > 0 {
+			sentence.TokenFrom = sentence.Tokens[0]
+			sentence.TokenTo = sentence.Tokens[len(sentence.Tokens)-1]
+		}
+		doc.Sentences = append(doc.Sentences, sentence)
+
+		if len(s.Dependencies) > 0 {
+			depGraph := s.Dependencies[0]
+			for _, g := range s.Dependencies {
+				if g.Type == basicDependencies {
+					depGraph = g
+					break
+				}
+			}
+			tree := jsonnlp.DependencyTree{SentenceID: s.ID, Style: depGraph.Type}
+			for _, e := range depGraph.Edges {
+				if e.Governor.Idx == 0 {
+					// root dependency, CoreNLP encodes the governor as token 0
+					continue
+				}
+				tree.Dependencies = append(tree.Dependencies, jsonnlp.Dependency{
+					Label:     e.Type,
+					Governor:  sentenceFirstToken[s.ID] + e.Governor.Idx - 1,
+					Dependent: sentenceFirstToken[s.ID] + e.Dependent.Idx - 1,
+				})
+			}
+			doc.DependencyTrees = append(doc.DependencyTrees, tree)
+		}
+	}
+
+	for i, c := range root.Document.Coreferences {
+		coref := jsonnlp.Coreference{ID: i + 1}
+		for _, m := range c.Mentions {
+			tokenFrom := sentenceFirstToken[m.Sentence] + m.Start - 1
+			tokenTo := sentenceFirstToken[m.Sentence] + m.End - 2
+			head := sentenceFirstToken[m.Sentence] + m.Head - 1
+			tokens := make([]int, 0, tokenTo-tokenFrom+1)
+			for id := tokenFrom; id <= tokenTo; id++ {
+				tokens = append(tokens, id)
+			}
+			if m.Representative {
+				coref.Representative = jsonnlp.CoreferenceRepresentantive{Tokens: tokens, Head: head}
+			} else {
+				coref.Referents = append(coref.Referents, jsonnlp.CoreferenceReferents{Tokens: tokens, Head: head})
+			}
+		}
+		doc.Coreferences = append(doc.Coreferences, coref)
+	}
+
+	for _, tid := range timexOrder {
+		doc.Timexes = append(doc.Timexes, *timexes[tid])
+	}
+
+	for _, q := range root.Document.Quotes {
+		quote := jsonnlp.Quote{
+			ID:      q.ID,
+			Speaker: q.Speaker,
+			Mention: q.Text,
+			Begin:   q.Begin + 1,
+			End:     q.End,
+		}
+		for sid := q.SentenceBegin; sid <= q.SentenceEnd; sid++ {
+			quote.SentenceIDs = append(quote.SentenceIDs, sid)
+		}
+		doc.Quotes = append(doc.Quotes, quote)
+	}
+
+	doc.Mentions = mentionsFromTokens(nerTokens)
+
+	data.Documents = append(data.Documents, doc)
+	return data, nil
+}
@@ -0,0 +1,160 @@
+package corenlp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/SemiringInc/jsonnlp"
+)
+
+// coreNLPXML is a trimmed -outputFormat xml document covering a TIMEX3
+// date, a quote, and a PERSON mention, the three things chunk0-6 added to
+// the schema.
+const coreNLPXML = `<root>
+  <document>
+    <sentences>
+      <sentence id="1">
+        <tokens>
+          <token id="1">
+            <word>Alice</word>
+            <lemma>Alice</lemma>
+            <CharacterOffsetBegin>0</CharacterOffsetBegin>
+            <CharacterOffsetEnd>5</CharacterOffsetEnd>
+            <POS>NNP</POS>
+            <NER>PERSON</NER>
+          </token>
+          <token id="2">
+            <word>said</word>
+            <lemma>say</lemma>
+            <CharacterOffsetBegin>6</CharacterOffsetBegin>
+            <CharacterOffsetEnd>10</CharacterOffsetEnd>
+            <POS>VBD</POS>
+            <NER>O</NER>
+          </token>
+          <token id="3">
+            <word>2020-05-28</word>
+            <lemma>2020-05-28</lemma>
+            <CharacterOffsetBegin>11</CharacterOffsetBegin>
+            <CharacterOffsetEnd>21</CharacterOffsetEnd>
+            <POS>CD</POS>
+            <NER>DATE</NER>
+            <NormalizedNER>2020-05-28</NormalizedNER>
+            <Timex tid="t1" type="DATE">2020-05-28</Timex>
+          </token>
+        </tokens>
+      </sentence>
+    </sentences>
+    <quotes>
+      <quote id="1">
+        <text>Hello</text>
+        <begin>0</begin>
+        <end>1</end>
+        <sentenceBegin>1</sentenceBegin>
+        <sentenceEnd>1</sentenceEnd>
+        <speaker>Alice</speaker>
+      </quote>
+    </quotes>
+  </document>
+</root>`
+
+// TestFromCoreNLPXMLTemporalRoundTrip verifies that Timex, Quote, and
+// NERMention data survive a FromCoreNLPXML conversion followed by a
+// JSON-NLP marshal/unmarshal round trip.
+func TestFromCoreNLPXMLTemporalRoundTrip(t *testing.T) {
+	data, err := FromCoreNLPXML(strings.NewReader(coreNLPXML))
+	if err != nil {
+		t.Fatalf("FromCoreNLPXML: %v", err)
+	}
+
+	raw, err := data.GetJSON()
+	if err != nil {
+		t.Fatalf("GetJSON: %v", err)
+	}
+
+	var roundTripped jsonnlp.JSONNLP
+	roundTripped.FromString(string(raw))
+
+	if len(roundTripped.Documents) != 1 {
+		t.Fatalf("got %d documents, want 1", len(roundTripped.Documents))
+	}
+	doc := roundTripped.Documents[0]
+
+	if len(doc.Timexes) != 1 {
+		t.Fatalf("got %d timexes, want 1", len(doc.Timexes))
+	}
+	if tx := doc.Timexes[0]; tx.TID != "t1" || tx.Type != "DATE" || tx.Value != "2020-05-28" || tx.TokenFrom != 3 || tx.TokenTo != 3 {
+		t.Errorf("unexpected timex: %+v", tx)
+	}
+
+	if len(doc.Quotes) != 1 {
+		t.Fatalf("got %d quotes, want 1", len(doc.Quotes))
+	}
+	if q := doc.Quotes[0]; q.Speaker != "Alice" || q.Mention != "Hello" || q.Begin != 1 || q.End != 1 || len(q.SentenceIDs) != 1 || q.SentenceIDs[0] != 1 {
+		t.Errorf("unexpected quote: %+v", q)
+	}
+
+	if len(doc.Mentions) != 2 {
+		t.Fatalf("got %d mentions, want 2", len(doc.Mentions))
+	}
+	if m := doc.Mentions[0]; m.Entity != "PERSON" || m.Text != "Alice" || m.TokenFrom != 1 || m.TokenTo != 1 {
+		t.Errorf("unexpected PERSON mention: %+v", m)
+	}
+	if m := doc.Mentions[1]; m.Entity != "DATE" || m.NormalizedValue != "2020-05-28" || m.TokenFrom != 3 || m.TokenTo != 3 {
+		t.Errorf("unexpected DATE mention: %+v", m)
+	}
+}
+
+// multiTokenEntityXML covers a two-token LOCATION entity so EntityIOB's
+// continuation tag can be asserted independently of mentionsFromTokens,
+// which groups by Entity value and would mask a B/B bug.
+const multiTokenEntityXML = `<root>
+  <document>
+    <sentences>
+      <sentence id="1">
+        <tokens>
+          <token id="1">
+            <word>New</word>
+            <lemma>New</lemma>
+            <POS>NNP</POS>
+            <NER>LOCATION</NER>
+          </token>
+          <token id="2">
+            <word>York</word>
+            <lemma>York</lemma>
+            <POS>NNP</POS>
+            <NER>LOCATION</NER>
+          </token>
+          <token id="3">
+            <word>is</word>
+            <lemma>be</lemma>
+            <POS>VBZ</POS>
+            <NER>O</NER>
+          </token>
+        </tokens>
+      </sentence>
+    </sentences>
+  </document>
+</root>`
+
+// TestFromCoreNLPXMLEntityIOB verifies that consecutive tokens carrying the
+// same NER tag get "B"/"I" continuation tags rather than "B" for every one.
+func TestFromCoreNLPXMLEntityIOB(t *testing.T) {
+	data, err := FromCoreNLPXML(strings.NewReader(multiTokenEntityXML))
+	if err != nil {
+		t.Fatalf("FromCoreNLPXML: %v", err)
+	}
+	doc := data.Documents[0]
+
+	if len(doc.TokenList) != 3 {
+		t.Fatalf("got %d tokens, want 3", len(doc.TokenList))
+	}
+	if tok := doc.TokenList[0]; tok.EntityIOB != "B" {
+		t.Errorf("token 1 EntityIOB = %q, want B", tok.EntityIOB)
+	}
+	if tok := doc.TokenList[1]; tok.EntityIOB != "I" {
+		t.Errorf("token 2 EntityIOB = %q, want I", tok.EntityIOB)
+	}
+	if tok := doc.TokenList[2]; tok.EntityIOB != "" {
+		t.Errorf("token 3 EntityIOB = %q, want empty", tok.EntityIOB)
+	}
+}
@@ -0,0 +1,185 @@
+/**
+ * JSONNLP package
+ * (C) 2020 by Semiring Inc., Damir Cavar
+ *
+ * streaming NDJSON and standard JSON-NLP decoding.
+ */
+
+package jsonnlp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+type decoderMode int
+
+const (
+	modeUnknown decoderMode = iota
+	modeNDJSON
+	modeStandard
+)
+
+// Decoder reads a sequence of Documents from a JSON-NLP stream one at a
+// time, so that corpora too large to fit in memory can be processed
+// without ever holding more than one Document at once.
+//
+// It accepts two shapes of input: NDJSON, where every line is a standalone
+// Document object, and the standard JSON-NLP document, a single object
+// with top-level "meta" and "documents" fields. The shape is detected from
+// the first value read off the stream, so callers do not need to know
+// which one they are getting in advance. In standard mode the "documents"
+// array is walked element by element rather than decoded in one shot, so
+// the whole array never needs to sit in memory at once.
+type Decoder struct {
+	jd       *json.Decoder
+	mode     decoderMode
+	drained  bool
+	buffered *Document
+	meta     Meta
+	started  bool
+}
+
+// NewDecoder returns a Decoder that reads from r.
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{jd: json.NewDecoder(r)}
+}
+
+// Next returns the next Document in the stream, or an error wrapping
+// io.EOF once the stream is exhausted.
+func (d *Decoder) Next() (*Document, error) {
+	if !d.started {
+		if err := d.detect(); err != nil {
+			return nil, err
+		}
+	}
+	if d.buffered != nil {
+		doc := d.buffered
+		d.buffered = nil
+		return doc, nil
+	}
+	if d.mode == modeStandard {
+		if d.drained {
+			return nil, io.EOF
+		}
+		if !d.jd.More() {
+			if err := d.finishStandard(); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		var doc Document
+		if err := d.jd.Decode(&doc); err != nil {
+			return nil, err
+		}
+		return &doc, nil
+	}
+	var doc Document
+	if err := d.jd.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// Meta returns the collection-level metadata of a standard-mode stream. It
+// is the zero Meta for NDJSON streams, which carry no such envelope.
+func (d *Decoder) Meta() Meta {
+	return d.meta
+}
+
+// detect walks the first top-level JSON value off the stream one token at
+// a time and decides whether it is the envelope of a standard JSON-NLP
+// document (which has a "documents" array) or the first Document of an
+// NDJSON stream. It stops as soon as it finds a "documents" field, leaving
+// the decoder positioned inside that array so Next can stream its
+// elements one at a time instead of buffering them all up front. Document
+// also has a top-level "meta" field, so "documents" is the only key that
+// disambiguates the two shapes.
+func (d *Decoder) detect() error {
+	d.started = true
+	tok, err := d.jd.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("jsonnlp: expected a JSON object, got %v", tok)
+	}
+
+	fields := map[string]json.RawMessage{}
+	for d.jd.More() {
+		keyTok, err := d.jd.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := keyTok.(string)
+
+		if key == "documents" {
+			arrTok, err := d.jd.Token()
+			if err != nil {
+				return err
+			}
+			if delim, ok := arrTok.(json.Delim); !ok || delim != '[' {
+				return fmt.Errorf("jsonnlp: \"documents\" is not an array")
+			}
+			d.mode = modeStandard
+			if raw, ok := fields["meta"]; ok {
+				if err := json.Unmarshal(raw, &d.meta); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		var raw json.RawMessage
+		if err := d.jd.Decode(&raw); err != nil {
+			return err
+		}
+		fields[key] = raw
+	}
+	if _, err := d.jd.Token(); err != nil { // closing '}'
+		return err
+	}
+
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	d.mode = modeNDJSON
+	var doc Document
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return err
+	}
+	d.buffered = &doc
+	return nil
+}
+
+// finishStandard consumes the rest of a standard-mode envelope once its
+// "documents" array has been fully read: the closing ']', any fields that
+// followed "documents" (picking up "meta" if it comes after, rather than
+// before, the array), and the closing '}'.
+func (d *Decoder) finishStandard() error {
+	d.drained = true
+	if _, err := d.jd.Token(); err != nil { // closing ']'
+		return err
+	}
+	for d.jd.More() {
+		keyTok, err := d.jd.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := keyTok.(string)
+		if key == "meta" {
+			if err := d.jd.Decode(&d.meta); err != nil {
+				return err
+			}
+			continue
+		}
+		var raw json.RawMessage
+		if err := d.jd.Decode(&raw); err != nil {
+			return err
+		}
+	}
+	_, err := d.jd.Token() // closing '}'
+	return err
+}
@@ -0,0 +1,111 @@
+package jsonnlp
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+// TestDecoderNDJSON verifies that a stream of newline-delimited Document
+// objects, with no "documents" envelope, is read one Document per line.
+func TestDecoderNDJSON(t *testing.T) {
+	const stream = `{"meta":{"DC.conformsTo":""},"id":1}
+{"meta":{"DC.conformsTo":""},"id":2}
+`
+	dec := NewDecoder(strings.NewReader(stream))
+
+	doc1, err := dec.Next()
+	if err != nil {
+		t.Fatalf("Next (1): %v", err)
+	}
+	if doc1.ID != 1 {
+		t.Errorf("doc1.ID = %d, want 1", doc1.ID)
+	}
+
+	doc2, err := dec.Next()
+	if err != nil {
+		t.Fatalf("Next (2): %v", err)
+	}
+	if doc2.ID != 2 {
+		t.Errorf("doc2.ID = %d, want 2", doc2.ID)
+	}
+
+	if _, err := dec.Next(); err != io.EOF {
+		t.Errorf("Next (3) = %v, want io.EOF", err)
+	}
+
+	if dec.Meta() != (Meta{}) {
+		t.Errorf("Meta() = %+v, want zero value for NDJSON", dec.Meta())
+	}
+}
+
+// TestDecoderStandardMetaBeforeDocuments verifies the standard JSON-NLP
+// shape with "meta" preceding "documents", the common ordering.
+func TestDecoderStandardMetaBeforeDocuments(t *testing.T) {
+	const stream = `{"meta":{"DC.conformsTo":"https://www.w3.org/submissions/2006/SUBM-json-ld-20060918/"},"documents":[{"meta":{},"id":1},{"meta":{},"id":2}]}`
+	dec := NewDecoder(strings.NewReader(stream))
+
+	doc1, err := dec.Next()
+	if err != nil {
+		t.Fatalf("Next (1): %v", err)
+	}
+	if doc1.ID != 1 {
+		t.Errorf("doc1.ID = %d, want 1", doc1.ID)
+	}
+
+	doc2, err := dec.Next()
+	if err != nil {
+		t.Fatalf("Next (2): %v", err)
+	}
+	if doc2.ID != 2 {
+		t.Errorf("doc2.ID = %d, want 2", doc2.ID)
+	}
+
+	if _, err := dec.Next(); err != io.EOF {
+		t.Errorf("Next (3) = %v, want io.EOF", err)
+	}
+
+	want := "https://www.w3.org/submissions/2006/SUBM-json-ld-20060918/"
+	if dec.Meta().DCConformsTo != want {
+		t.Errorf("Meta().DCConformsTo = %q, want %q", dec.Meta().DCConformsTo, want)
+	}
+}
+
+// TestDecoderStandardMetaAfterDocuments verifies the standard shape still
+// picks up "meta" when it trails the "documents" array, which
+// finishStandard rather than detect is responsible for.
+func TestDecoderStandardMetaAfterDocuments(t *testing.T) {
+	const stream = `{"documents":[{"meta":{},"id":1}],"meta":{"DC.conformsTo":"trailing"}}`
+	dec := NewDecoder(strings.NewReader(stream))
+
+	doc, err := dec.Next()
+	if err != nil {
+		t.Fatalf("Next (1): %v", err)
+	}
+	if doc.ID != 1 {
+		t.Errorf("doc.ID = %d, want 1", doc.ID)
+	}
+
+	if _, err := dec.Next(); err != io.EOF {
+		t.Errorf("Next (2) = %v, want io.EOF", err)
+	}
+
+	if dec.Meta().DCConformsTo != "trailing" {
+		t.Errorf("Meta().DCConformsTo = %q, want %q", dec.Meta().DCConformsTo, "trailing")
+	}
+}
+
+// TestDecoderStandardEmptyDocuments verifies an empty "documents" array
+// reaches io.EOF on the very first Next call rather than hanging or
+// erroring.
+func TestDecoderStandardEmptyDocuments(t *testing.T) {
+	const stream = `{"meta":{"DC.conformsTo":"x"},"documents":[]}`
+	dec := NewDecoder(strings.NewReader(stream))
+
+	if _, err := dec.Next(); err != io.EOF {
+		t.Errorf("Next = %v, want io.EOF", err)
+	}
+	if dec.Meta().DCConformsTo != "x" {
+		t.Errorf("Meta().DCConformsTo = %q, want %q", dec.Meta().DCConformsTo, "x")
+	}
+}
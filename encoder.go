@@ -0,0 +1,30 @@
+/**
+ * JSONNLP package
+ * (C) 2020 by Semiring Inc., Damir Cavar
+ *
+ * streaming NDJSON encoding.
+ */
+
+package jsonnlp
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Encoder writes a sequence of Documents to w as NDJSON, one JSON object
+// per line, so that corpora too large to build in memory can be streamed
+// out Document by Document.
+type Encoder struct {
+	je *json.Encoder
+}
+
+// NewEncoder returns an Encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{je: json.NewEncoder(w)}
+}
+
+// Encode writes doc to the stream, terminated by a newline.
+func (e *Encoder) Encode(doc *Document) error {
+	return e.je.Encode(doc)
+}
@@ -0,0 +1,40 @@
+package jsonnlp
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestEncoderWritesNDJSON verifies that Encoder writes one Document per
+// line and that a Decoder reading the result back sees the same Documents,
+// matching the NDJSON shape Decoder detects automatically.
+func TestEncoderWritesNDJSON(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf)
+
+	docs := []*Document{
+		{ID: 1, TokenList: []Token{{ID: 1, Text: "Alice"}}},
+		{ID: 2, TokenList: []Token{{ID: 1, Text: "Bob"}}},
+	}
+	for _, doc := range docs {
+		if err := enc.Encode(doc); err != nil {
+			t.Fatalf("Encode: %v", err)
+		}
+	}
+
+	if n := strings.Count(buf.String(), "\n"); n != 2 {
+		t.Fatalf("got %d lines, want 2: %q", n, buf.String())
+	}
+
+	dec := NewDecoder(&buf)
+	for i, want := range docs {
+		got, err := dec.Next()
+		if err != nil {
+			t.Fatalf("Next (%d): %v", i, err)
+		}
+		if got.ID != want.ID || len(got.TokenList) != 1 || got.TokenList[0].Text != want.TokenList[0].Text {
+			t.Errorf("doc %d: got %+v, want %+v", i, got, want)
+		}
+	}
+}
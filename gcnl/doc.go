@@ -0,0 +1,16 @@
+/**
+ * JSONNLP package
+ * (C) 2020 by Semiring Inc., Damir Cavar
+ *
+ * Package gcnl converts Google Cloud Natural Language API responses into
+ * JSON-NLP.
+ *
+ * It accepts the language.AnnotateTextResponse returned by the combined
+ * AnnotateText call, or any of the narrower AnalyzeEntitiesResponse,
+ * AnalyzeSentimentResponse, AnalyzeSyntaxResponse, and
+ * AnalyzeEntitySentimentResponse messages, and maps tokens, dependency edges,
+ * sentence sentiment, and entity mentions onto the corresponding
+ * *jsonnlp.Document fields.
+ */
+
+package gcnl // import "github.com/SemiringInc/jsonnlp/gcnl"
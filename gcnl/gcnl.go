@@ -0,0 +1,263 @@
+package gcnl
+
+import (
+	languagepb "cloud.google.com/go/language/apiv1/languagepb"
+
+	"github.com/SemiringInc/jsonnlp"
+)
+
+// FromAnnotateText converts the combined result of a
+// language.AnnotateText call into a JSON-NLP document.
+func FromAnnotateText(resp *languagepb.AnnotateTextResponse) (*jsonnlp.Document, error) {
+	doc := &jsonnlp.Document{ID: 1}
+	tokens := resp.GetTokens()
+	sentences := resp.GetSentences()
+	offsets := tokenOffsets(tokens)
+	sentenceIDs := assignSentenceIDs(tokens, sentences)
+
+	addTokens(doc, tokens, sentenceIDs)
+	addSentences(doc, sentences, groupTokensBySentence(sentenceIDs))
+	addDependencies(doc, tokens, sentenceIDs)
+	addEntities(doc, resp.GetEntities(), tokens, offsets)
+
+	return doc, nil
+}
+
+// FromEntities converts an AnalyzeEntities result into a JSON-NLP document.
+// Tokens are not available from this call, so entities are emitted without
+// a token span: TokenFrom and TokenTo are left at 0.
+func FromEntities(resp *languagepb.AnalyzeEntitiesResponse) (*jsonnlp.Document, error) {
+	doc := &jsonnlp.Document{ID: 1}
+	addEntities(doc, resp.GetEntities(), nil, nil)
+	return doc, nil
+}
+
+// FromSentiment converts an AnalyzeSentiment result into a JSON-NLP document.
+func FromSentiment(resp *languagepb.AnalyzeSentimentResponse) (*jsonnlp.Document, error) {
+	doc := &jsonnlp.Document{ID: 1}
+	addSentences(doc, resp.GetSentences(), nil)
+	return doc, nil
+}
+
+// assignSentenceIDs walks tokens and sentences in document order (both are
+// already sorted by character offset in GCNL responses) and returns the
+// 1-based JSON-NLP sentence ID each token belongs to.
+func assignSentenceIDs(tokens []*languagepb.Token, sentences []*languagepb.Sentence) []int {
+	ids := make([]int, len(tokens))
+	sentIdx := 0
+	for i, t := range tokens {
+		begin := t.GetText().GetBeginOffset()
+		for sentIdx+1 < len(sentences) && sentences[sentIdx+1].GetText().GetBeginOffset() <= begin {
+			sentIdx++
+		}
+		ids[i] = sentIdx + 1
+	}
+	return ids
+}
+
+// groupTokensBySentence buckets 1-based token IDs by the 1-based sentence ID
+// assignSentenceIDs computed for them.
+func groupTokensBySentence(sentenceIDs []int) map[int][]int {
+	grouped := make(map[int][]int, len(sentenceIDs))
+	for i, sentenceID := range sentenceIDs {
+		grouped[sentenceID] = append(grouped[sentenceID], i+1)
+	}
+	return grouped
+}
+
+// FromSyntax converts an AnalyzeSyntax result into a JSON-NLP document.
+func FromSyntax(resp *languagepb.AnalyzeSyntaxResponse) (*jsonnlp.Document, error) {
+	doc := &jsonnlp.Document{ID: 1}
+	tokens := resp.GetTokens()
+	sentences := resp.GetSentences()
+	sentenceIDs := assignSentenceIDs(tokens, sentences)
+
+	addTokens(doc, tokens, sentenceIDs)
+	addSentences(doc, sentences, groupTokensBySentence(sentenceIDs))
+	addDependencies(doc, tokens, sentenceIDs)
+
+	return doc, nil
+}
+
+// FromEntitySentiment converts an AnalyzeEntitySentiment result into a
+// JSON-NLP document.
+func FromEntitySentiment(resp *languagepb.AnalyzeEntitySentimentResponse) (*jsonnlp.Document, error) {
+	doc := &jsonnlp.Document{ID: 1}
+	addEntities(doc, resp.GetEntities(), nil, nil)
+	return doc, nil
+}
+
+// tokenOffsets maps a token's character BeginOffset to its 1-based JSON-NLP
+// token ID, so that entity mentions (which only carry a TextSpan) can be
+// resolved to token IDs.
+func tokenOffsets(tokens []*languagepb.Token) map[int32]int {
+	m := make(map[int32]int, len(tokens))
+	for i, t := range tokens {
+		if span := t.GetText(); span != nil {
+			m[span.GetBeginOffset()] = i + 1
+		}
+	}
+	return m
+}
+
+func addTokens(doc *jsonnlp.Document, tokens []*languagepb.Token, sentenceIDs []int) {
+	for i, t := range tokens {
+		pos := t.GetPartOfSpeech()
+		doc.TokenList = append(doc.TokenList, jsonnlp.Token{
+			ID:                   i + 1,
+			SentenceID:           sentenceIDs[i],
+			Text:                 t.GetText().GetContent(),
+			Lemma:                t.GetLemma(),
+			XPoS:                 pos.GetTag().String(),
+			CharacterOffsetBegin: int(t.GetText().GetBeginOffset()),
+			CharacterOffsetEnd:   int(t.GetText().GetBeginOffset()) + len(t.GetText().GetContent()),
+			Features: jsonnlp.TokenFeatures{
+				Case:       pos.GetCase().String(),
+				Gender:     pos.GetGender().String(),
+				Mood:       pos.GetMood().String(),
+				Number:     gcnlNumber(pos.GetNumber()),
+				Person:     gcnlPerson(pos.GetPerson()),
+				Tense:      pos.GetTense().String(),
+				Perfect:    pos.GetAspect() == languagepb.PartOfSpeech_PERFECTIVE,
+				Continuous: pos.GetAspect() == languagepb.PartOfSpeech_PROGRESSIVE,
+				Voice:      pos.GetVoice().String(),
+			},
+		})
+	}
+}
+
+// gcnlNumber maps the GCNL PartOfSpeech.Number enum onto the JSON-NLP
+// integer convention: 1 = singular, 2 = dual, 3 or more = plural.
+func gcnlNumber(n languagepb.PartOfSpeech_Number) int {
+	switch n {
+	case languagepb.PartOfSpeech_SINGULAR:
+		return 1
+	case languagepb.PartOfSpeech_DUAL:
+		return 2
+	case languagepb.PartOfSpeech_PLURAL:
+		return 3
+	}
+	return 0
+}
+
+func gcnlPerson(p languagepb.PartOfSpeech_Person) int {
+	switch p {
+	case languagepb.PartOfSpeech_FIRST:
+		return 1
+	case languagepb.PartOfSpeech_SECOND:
+		return 2
+	case languagepb.PartOfSpeech_THIRD:
+		return 3
+	}
+	return 0
+}
+
+func addSentences(doc *jsonnlp.Document, sentences []*languagepb.Sentence, tokensBySentence map[int][]int) {
+	for i, s := range sentences {
+		sentence := jsonnlp.Sentence{ID: i + 1}
+		if sentiment := s.GetSentiment(); sentiment != nil {
+			sentence.Sentiment = sentimentLabel(sentiment.GetScore())
+			sentence.SentimentProbability = float64(sentiment.GetMagnitude())
+		}
+		if toks := tokensBySentence[i+1]; len(toks) > 0 {
+			sentence.Tokens = toks
+			sentence.TokenFrom = toks[0]
+			sentence.TokenTo = toks[len(toks)-1]
+		}
+		doc.Sentences = append(doc.Sentences, sentence)
+	}
+}
+
+// sentimentLabel buckets the GCNL [-1.0, 1.0] sentiment score into the
+// positive/neutral/negative labels JSON-NLP expects in Sentence.Sentiment.
+func sentimentLabel(score float32) string {
+	switch {
+	case score > 0.25:
+		return "positive"
+	case score < -0.25:
+		return "negative"
+	default:
+		return "neutral"
+	}
+}
+
+func addDependencies(doc *jsonnlp.Document, tokens []*languagepb.Token, sentenceIDs []int) {
+	trees := map[int]*jsonnlp.DependencyTree{}
+	var order []int
+	for i, t := range tokens {
+		edge := t.GetDependencyEdge()
+		if edge == nil {
+			continue
+		}
+		head := int(edge.GetHeadTokenIndex()) + 1
+		if head == i+1 {
+			// GCNL marks the sentence root by pointing the head at itself.
+			continue
+		}
+		sentenceID := sentenceIDs[i]
+		tree, ok := trees[sentenceID]
+		if !ok {
+			tree = &jsonnlp.DependencyTree{SentenceID: sentenceID, Style: "universal-dependencies"}
+			trees[sentenceID] = tree
+			order = append(order, sentenceID)
+		}
+		tree.Dependencies = append(tree.Dependencies, jsonnlp.Dependency{
+			Label:     edge.GetLabel().String(),
+			Governor:  head,
+			Dependent: i + 1,
+		})
+	}
+	for _, sentenceID := range order {
+		doc.DependencyTrees = append(doc.DependencyTrees, *trees[sentenceID])
+	}
+}
+
+func addEntities(doc *jsonnlp.Document, entities []*languagepb.Entity, tokens []*languagepb.Token, offsets map[int32]int) {
+	id := 1
+	for _, e := range entities {
+		if len(e.GetMentions()) == 0 {
+			doc.Entities = append(doc.Entities, jsonnlp.Entity{
+				ID:    id,
+				Label: e.GetName(),
+				Type:  e.GetType().String(),
+			})
+			id++
+			continue
+		}
+		for _, m := range e.GetMentions() {
+			entity := jsonnlp.Entity{
+				ID:    id,
+				Label: e.GetName(),
+				Type:  e.GetType().String(),
+			}
+			if span := m.GetText(); span != nil {
+				entity.TokenFrom = offsets[span.GetBeginOffset()]
+				entity.TokenTo = lastTokenBefore(tokens, offsets, span.GetBeginOffset()+int32(len(span.GetContent())))
+			}
+			if sentiment := m.GetSentiment(); sentiment != nil {
+				entity.Sentiment = sentimentLabel(sentiment.GetScore())
+				entity.SentimentProbability = float64(sentiment.GetMagnitude())
+			}
+			doc.Entities = append(doc.Entities, entity)
+			id++
+		}
+	}
+}
+
+// lastTokenBefore returns the JSON-NLP ID of the last token whose begin
+// offset falls inside [0, end), so that a multi-token entity mention's
+// TokenTo can be derived from its character span rather than collapsing to
+// TokenFrom.
+func lastTokenBefore(tokens []*languagepb.Token, offsets map[int32]int, end int32) int {
+	last := 0
+	for _, t := range tokens {
+		span := t.GetText()
+		if span == nil || span.GetBeginOffset() >= end {
+			break
+		}
+		if id, ok := offsets[span.GetBeginOffset()]; ok {
+			last = id
+		}
+	}
+	return last
+}
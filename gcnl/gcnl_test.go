@@ -0,0 +1,75 @@
+package gcnl
+
+import (
+	"testing"
+
+	languagepb "cloud.google.com/go/language/apiv1/languagepb"
+)
+
+func span(content string, begin int32) *languagepb.TextSpan {
+	return &languagepb.TextSpan{Content: content, BeginOffset: begin}
+}
+
+// TestFromAnnotateText covers per-sentence token assignment, per-sentence
+// dependency trees, and a multi-token entity mention, which is the
+// behavior chunk0-2's review comments called out as broken.
+func TestFromAnnotateText(t *testing.T) {
+	resp := &languagepb.AnnotateTextResponse{
+		Sentences: []*languagepb.Sentence{
+			{Text: span("New York is big.", 0)},
+			{Text: span("It is busy.", 18)},
+		},
+		Tokens: []*languagepb.Token{
+			{Text: span("New", 0), DependencyEdge: &languagepb.DependencyEdge{HeadTokenIndex: 1, Label: languagepb.DependencyEdge_NN}},
+			{Text: span("York", 4), DependencyEdge: &languagepb.DependencyEdge{HeadTokenIndex: 1, Label: languagepb.DependencyEdge_NN}},
+			{Text: span("is", 9), DependencyEdge: &languagepb.DependencyEdge{HeadTokenIndex: 1, Label: languagepb.DependencyEdge_ROOT}},
+			{Text: span("big", 12), DependencyEdge: &languagepb.DependencyEdge{HeadTokenIndex: 2, Label: languagepb.DependencyEdge_ACOMP}},
+			{Text: span("It", 18), DependencyEdge: &languagepb.DependencyEdge{HeadTokenIndex: 5, Label: languagepb.DependencyEdge_NSUBJ}},
+			{Text: span("is", 21), DependencyEdge: &languagepb.DependencyEdge{HeadTokenIndex: 5, Label: languagepb.DependencyEdge_ROOT}},
+		},
+		Entities: []*languagepb.Entity{
+			{
+				Name: "New York",
+				Type: languagepb.Entity_LOCATION,
+				Mentions: []*languagepb.EntityMention{
+					{Text: span("New York", 0)},
+				},
+			},
+		},
+	}
+
+	doc, err := FromAnnotateText(resp)
+	if err != nil {
+		t.Fatalf("FromAnnotateText: %v", err)
+	}
+
+	if len(doc.TokenList) != 6 {
+		t.Fatalf("got %d tokens, want 6", len(doc.TokenList))
+	}
+	for i, want := range []int{1, 1, 1, 1, 2, 2} {
+		if got := doc.TokenList[i].SentenceID; got != want {
+			t.Errorf("token %d SentenceID = %d, want %d", i, got, want)
+		}
+	}
+
+	if len(doc.Sentences) != 2 {
+		t.Fatalf("got %d sentences, want 2", len(doc.Sentences))
+	}
+	if s := doc.Sentences[0]; s.TokenFrom != 1 || s.TokenTo != 4 {
+		t.Errorf("sentence 1 span = [%d,%d], want [1,4]", s.TokenFrom, s.TokenTo)
+	}
+	if s := doc.Sentences[1]; s.TokenFrom != 5 || s.TokenTo != 6 {
+		t.Errorf("sentence 2 span = [%d,%d], want [5,6]", s.TokenFrom, s.TokenTo)
+	}
+
+	if len(doc.DependencyTrees) != 2 {
+		t.Fatalf("got %d dependency trees, want 2 (one per sentence)", len(doc.DependencyTrees))
+	}
+
+	if len(doc.Entities) != 1 {
+		t.Fatalf("got %d entities, want 1", len(doc.Entities))
+	}
+	if e := doc.Entities[0]; e.TokenFrom != 1 || e.TokenTo != 2 {
+		t.Errorf("multi-token entity span = [%d,%d], want [1,2]", e.TokenFrom, e.TokenTo)
+	}
+}
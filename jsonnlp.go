@@ -11,7 +11,9 @@ package jsonnlp
 
 import (
 	"encoding/json"
-	"io/ioutil"
+	"io"
+	"os"
+	"strings"
 )
 
 const version string = "0.8.3"
@@ -53,7 +55,8 @@ type TokenFeatures struct {
 	Counterfactive bool   `json:"counterfactive,omitempty"`
 	Irregular      bool   `json:"irregular,omitempty"` // irregular verb or noun form
 	PhrasalVerb    bool   `json:"phrasalVerb,omitempty"`
-	Mood           string `json:"mood,omitempty"` // indicative, imperative, subjunctive
+	Mood           string `json:"mood,omitempty"`  // indicative, imperative, subjunctive
+	Voice          string `json:"voice,omitempty"` // active, passive
 	Foreign        bool   `json:"foreign,omitempty"`
 	SpaceAfter     bool   `json:"spaceAfter,omitempty"` // space after token in orig text?
 }
@@ -265,6 +268,9 @@ type Document struct {
 	Entities        []Entity           `json:"entities,omitempty"`
 	Relations       []Relation         `json:"relations,omitempty"`
 	Triples         []Triple           `json:"triples,omitempty"`
+	Timexes         []Timex            `json:"timexes,omitempty"`
+	Quotes          []Quote            `json:"quotes,omitempty"`
+	Mentions        []NERMention       `json:"mentions,omitempty"`
 }
 
 // JSONNLP is
@@ -273,17 +279,36 @@ type JSONNLP struct {
 	Documents []Document `json:"documents,omitempty"`
 }
 
-// FromString reads the JSON-NLP instance from a string.
+// FromString reads the JSON-NLP instance from a string, accepting either
+// the standard JSON-NLP document or an NDJSON stream of Documents.
 func (data *JSONNLP) FromString(t string) {
-	// TODO check whether data has any content
-	_ = json.Unmarshal([]byte(t), data)
+	data.fromReader(strings.NewReader(t))
 }
 
-// FromFile reads the JSON-NLP instance from a file.
+// FromFile reads the JSON-NLP instance from a file, accepting either the
+// standard JSON-NLP document or an NDJSON stream of Documents.
 func (data *JSONNLP) FromFile(filename string) {
-	// TODO check whether data has any content
-	file, _ := ioutil.ReadFile(filename)
-	_ = json.Unmarshal([]byte(file), data)
+	file, err := os.Open(filename)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+	data.fromReader(file)
+}
+
+// fromReader drains a Decoder into data, so that FromFile and FromString
+// keep their one-shot signatures while reading the corpus Document by
+// Document under the hood.
+func (data *JSONNLP) fromReader(r io.Reader) {
+	dec := NewDecoder(r)
+	for {
+		doc, err := dec.Next()
+		if err != nil {
+			break
+		}
+		data.Documents = append(data.Documents, *doc)
+	}
+	data.MetaData = dec.Meta()
 }
 
 // GetJSON returns the JSON-NLP instance as a byte array.
@@ -0,0 +1,12 @@
+/**
+ * JSONNLP package
+ * (C) 2020 by Semiring Inc., Damir Cavar
+ *
+ * Package pb holds the protobuf and gRPC bindings for JSON-NLP. The message
+ * types in jsonnlp.pb.go are written by hand to match jsonnlp.proto rather
+ * than generated by protoc-gen-go. Use jsonnlp.ToProto and jsonnlp.FromProto to
+ * convert between the wire types here and the *jsonnlp.Document structs used
+ * throughout the rest of this module.
+ */
+
+package pb // import "github.com/SemiringInc/jsonnlp/pb"
@@ -0,0 +1,1842 @@
+// Written by hand to match the messages in jsonnlp.proto; not generated by
+// protoc-gen-go.
+// source: jsonnlp.proto
+
+package pb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+)
+
+type Meta struct {
+	DcConformsTo  string `protobuf:"bytes,1,opt,name=dc_conforms_to,json=dcConformsTo,proto3" json:"dc_conforms_to,omitempty"`
+	DcAuthor      string `protobuf:"bytes,2,opt,name=dc_author,json=dcAuthor,proto3" json:"dc_author,omitempty"`
+	DcCreated     string `protobuf:"bytes,3,opt,name=dc_created,json=dcCreated,proto3" json:"dc_created,omitempty"`
+	DcDate        string `protobuf:"bytes,4,opt,name=dc_date,json=dcDate,proto3" json:"dc_date,omitempty"`
+	DcSource      string `protobuf:"bytes,5,opt,name=dc_source,json=dcSource,proto3" json:"dc_source,omitempty"`
+	DcLanguage    string `protobuf:"bytes,6,opt,name=dc_language,json=dcLanguage,proto3" json:"dc_language,omitempty"`
+	DcCreator     string `protobuf:"bytes,7,opt,name=dc_creator,json=dcCreator,proto3" json:"dc_creator,omitempty"`
+	DcPublisher   string `protobuf:"bytes,8,opt,name=dc_publisher,json=dcPublisher,proto3" json:"dc_publisher,omitempty"`
+	DcTitle       string `protobuf:"bytes,9,opt,name=dc_title,json=dcTitle,proto3" json:"dc_title,omitempty"`
+	DcDescription string `protobuf:"bytes,10,opt,name=dc_description,json=dcDescription,proto3" json:"dc_description,omitempty"`
+	DcIdentifier  string `protobuf:"bytes,11,opt,name=dc_identifier,json=dcIdentifier,proto3" json:"dc_identifier,omitempty"`
+}
+
+func (m *Meta) Reset()         { *m = Meta{} }
+func (m *Meta) String() string { return proto.CompactTextString(m) }
+func (*Meta) ProtoMessage()    {}
+
+type TokenFeatures struct {
+	Overt          bool   `protobuf:"varint,1,opt,name=overt,proto3" json:"overt,omitempty"`
+	Stop           bool   `protobuf:"varint,2,opt,name=stop,proto3" json:"stop,omitempty"`
+	Alpha          bool   `protobuf:"varint,3,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	Number         int32  `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	Gender         string `protobuf:"bytes,5,opt,name=gender,proto3" json:"gender,omitempty"`
+	Person         int32  `protobuf:"varint,6,opt,name=person,proto3" json:"person,omitempty"`
+	Tense          string `protobuf:"bytes,7,opt,name=tense,proto3" json:"tense,omitempty"`
+	Perfect        bool   `protobuf:"varint,8,opt,name=perfect,proto3" json:"perfect,omitempty"`
+	Continuous     bool   `protobuf:"varint,9,opt,name=continuous,proto3" json:"continuous,omitempty"`
+	Progressive    bool   `protobuf:"varint,10,opt,name=progressive,proto3" json:"progressive,omitempty"`
+	Case           string `protobuf:"bytes,11,opt,name=case,proto3" json:"case,omitempty"`
+	Human          bool   `protobuf:"varint,12,opt,name=human,proto3" json:"human,omitempty"`
+	Animate        bool   `protobuf:"varint,13,opt,name=animate,proto3" json:"animate,omitempty"`
+	Negated        bool   `protobuf:"varint,14,opt,name=negated,proto3" json:"negated,omitempty"`
+	Countable      bool   `protobuf:"varint,15,opt,name=countable,proto3" json:"countable,omitempty"`
+	Factive        bool   `protobuf:"varint,16,opt,name=factive,proto3" json:"factive,omitempty"`
+	Counterfactive bool   `protobuf:"varint,17,opt,name=counterfactive,proto3" json:"counterfactive,omitempty"`
+	Irregular      bool   `protobuf:"varint,18,opt,name=irregular,proto3" json:"irregular,omitempty"`
+	PhrasalVerb    bool   `protobuf:"varint,19,opt,name=phrasal_verb,json=phrasalVerb,proto3" json:"phrasal_verb,omitempty"`
+	Mood           string `protobuf:"bytes,20,opt,name=mood,proto3" json:"mood,omitempty"`
+	Foreign        bool   `protobuf:"varint,21,opt,name=foreign,proto3" json:"foreign,omitempty"`
+	SpaceAfter     bool   `protobuf:"varint,22,opt,name=space_after,json=spaceAfter,proto3" json:"space_after,omitempty"`
+	Voice          string `protobuf:"bytes,23,opt,name=voice,proto3" json:"voice,omitempty"`
+}
+
+func (m *TokenFeatures) Reset()         { *m = TokenFeatures{} }
+func (m *TokenFeatures) String() string { return proto.CompactTextString(m) }
+func (*TokenFeatures) ProtoMessage()    {}
+
+type Token struct {
+	Id                   int32          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SentenceId           int32          `protobuf:"varint,2,opt,name=sentence_id,json=sentenceId,proto3" json:"sentence_id,omitempty"`
+	Text                 string         `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Lemma                string         `protobuf:"bytes,4,opt,name=lemma,proto3" json:"lemma,omitempty"`
+	Xpos                 string         `protobuf:"bytes,5,opt,name=xpos,proto3" json:"xpos,omitempty"`
+	XposProb             float64        `protobuf:"fixed64,6,opt,name=xpos_prob,json=xposProb,proto3" json:"xpos_prob,omitempty"`
+	Upos                 string         `protobuf:"bytes,7,opt,name=upos,proto3" json:"upos,omitempty"`
+	UposProb             float64        `protobuf:"fixed64,8,opt,name=upos_prob,json=uposProb,proto3" json:"upos_prob,omitempty"`
+	EntityIob            string         `protobuf:"bytes,9,opt,name=entity_iob,json=entityIob,proto3" json:"entity_iob,omitempty"`
+	CharacterOffsetBegin int32          `protobuf:"varint,10,opt,name=character_offset_begin,json=characterOffsetBegin,proto3" json:"character_offset_begin,omitempty"`
+	CharacterOffsetEnd   int32          `protobuf:"varint,11,opt,name=character_offset_end,json=characterOffsetEnd,proto3" json:"character_offset_end,omitempty"`
+	PropId               string         `protobuf:"bytes,12,opt,name=prop_id,json=propId,proto3" json:"prop_id,omitempty"`
+	PropIdProbability    float64        `protobuf:"fixed64,13,opt,name=prop_id_probability,json=propIdProbability,proto3" json:"prop_id_probability,omitempty"`
+	FrameId              int32          `protobuf:"varint,14,opt,name=frame_id,json=frameId,proto3" json:"frame_id,omitempty"`
+	FrameIdProbability   float64        `protobuf:"fixed64,15,opt,name=frame_id_probability,json=frameIdProbability,proto3" json:"frame_id_probability,omitempty"`
+	WordNetId            int32          `protobuf:"varint,16,opt,name=word_net_id,json=wordNetId,proto3" json:"word_net_id,omitempty"`
+	WordNetIdProbability float64        `protobuf:"fixed64,17,opt,name=word_net_id_probability,json=wordNetIdProbability,proto3" json:"word_net_id_probability,omitempty"`
+	VerbNetId            int32          `protobuf:"varint,18,opt,name=verb_net_id,json=verbNetId,proto3" json:"verb_net_id,omitempty"`
+	VerbNetIdProbability float64        `protobuf:"fixed64,19,opt,name=verb_net_id_probability,json=verbNetIdProbability,proto3" json:"verb_net_id_probability,omitempty"`
+	Lang                 string         `protobuf:"bytes,20,opt,name=lang,proto3" json:"lang,omitempty"`
+	Features             *TokenFeatures `protobuf:"bytes,21,opt,name=features,proto3" json:"features,omitempty"`
+	Shape                string         `protobuf:"bytes,22,opt,name=shape,proto3" json:"shape,omitempty"`
+	Entity               string         `protobuf:"bytes,23,opt,name=entity,proto3" json:"entity,omitempty"`
+}
+
+func (m *Token) Reset()         { *m = Token{} }
+func (m *Token) String() string { return proto.CompactTextString(m) }
+func (*Token) ProtoMessage()    {}
+
+func (m *Token) GetFeatures() *TokenFeatures {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+type Sentence struct {
+	Id                   int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TokenFrom            int32   `protobuf:"varint,2,opt,name=token_from,json=tokenFrom,proto3" json:"token_from,omitempty"`
+	TokenTo              int32   `protobuf:"varint,3,opt,name=token_to,json=tokenTo,proto3" json:"token_to,omitempty"`
+	Tokens               []int32 `protobuf:"varint,4,rep,packed,name=tokens,proto3" json:"tokens,omitempty"`
+	Clauses              []int32 `protobuf:"varint,5,rep,packed,name=clauses,proto3" json:"clauses,omitempty"`
+	Type                 string  `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	Sentiment            string  `protobuf:"bytes,7,opt,name=sentiment,proto3" json:"sentiment,omitempty"`
+	SentimentProbability float64 `protobuf:"fixed64,8,opt,name=sentiment_probability,json=sentimentProbability,proto3" json:"sentiment_probability,omitempty"`
+}
+
+func (m *Sentence) Reset()         { *m = Sentence{} }
+func (m *Sentence) String() string { return proto.CompactTextString(m) }
+func (*Sentence) ProtoMessage()    {}
+
+type Clause struct {
+	Id                   int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SentenceId           int32   `protobuf:"varint,2,opt,name=sentence_id,json=sentenceId,proto3" json:"sentence_id,omitempty"`
+	TokenFrom            int32   `protobuf:"varint,3,opt,name=token_from,json=tokenFrom,proto3" json:"token_from,omitempty"`
+	TokenTo              int32   `protobuf:"varint,4,opt,name=token_to,json=tokenTo,proto3" json:"token_to,omitempty"`
+	Tokens               []int32 `protobuf:"varint,5,rep,packed,name=tokens,proto3" json:"tokens,omitempty"`
+	Main                 bool    `protobuf:"varint,6,opt,name=main,proto3" json:"main,omitempty"`
+	Governor             int32   `protobuf:"varint,7,opt,name=governor,proto3" json:"governor,omitempty"`
+	Head                 int32   `protobuf:"varint,8,opt,name=head,proto3" json:"head,omitempty"`
+	Negation             bool    `protobuf:"varint,9,opt,name=negation,proto3" json:"negation,omitempty"`
+	Tense                string  `protobuf:"bytes,10,opt,name=tense,proto3" json:"tense,omitempty"`
+	Mood                 string  `protobuf:"bytes,11,opt,name=mood,proto3" json:"mood,omitempty"`
+	Perfect              bool    `protobuf:"varint,12,opt,name=perfect,proto3" json:"perfect,omitempty"`
+	Continuous           bool    `protobuf:"varint,13,opt,name=continuous,proto3" json:"continuous,omitempty"`
+	Aspect               string  `protobuf:"bytes,14,opt,name=aspect,proto3" json:"aspect,omitempty"`
+	Voice                string  `protobuf:"bytes,15,opt,name=voice,proto3" json:"voice,omitempty"`
+	Sentiment            string  `protobuf:"bytes,16,opt,name=sentiment,proto3" json:"sentiment,omitempty"`
+	SentimentProbability float64 `protobuf:"fixed64,17,opt,name=sentiment_probability,json=sentimentProbability,proto3" json:"sentiment_probability,omitempty"`
+}
+
+func (m *Clause) Reset()         { *m = Clause{} }
+func (m *Clause) String() string { return proto.CompactTextString(m) }
+func (*Clause) ProtoMessage()    {}
+
+type Dependency struct {
+	Label       string  `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Governor    int32   `protobuf:"varint,2,opt,name=governor,proto3" json:"governor,omitempty"`
+	Dependent   int32   `protobuf:"varint,3,opt,name=dependent,proto3" json:"dependent,omitempty"`
+	Probability float64 `protobuf:"fixed64,4,opt,name=probability,proto3" json:"probability,omitempty"`
+}
+
+func (m *Dependency) Reset()         { *m = Dependency{} }
+func (m *Dependency) String() string { return proto.CompactTextString(m) }
+func (*Dependency) ProtoMessage()    {}
+
+type DependencyTree struct {
+	SentenceId    int32         `protobuf:"varint,1,opt,name=sentence_id,json=sentenceId,proto3" json:"sentence_id,omitempty"`
+	Style         string        `protobuf:"bytes,2,opt,name=style,proto3" json:"style,omitempty"`
+	Dependencies  []*Dependency `protobuf:"bytes,3,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	Probability   float64       `protobuf:"fixed64,4,opt,name=probability,proto3" json:"probability,omitempty"`
+	HashOverHeads int32         `protobuf:"varint,5,opt,name=hash_over_heads,json=hashOverHeads,proto3" json:"hash_over_heads,omitempty"`
+}
+
+func (m *DependencyTree) Reset()         { *m = DependencyTree{} }
+func (m *DependencyTree) String() string { return proto.CompactTextString(m) }
+func (*DependencyTree) ProtoMessage()    {}
+
+func (m *DependencyTree) GetDependencies() []*Dependency {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+type CoreferenceRepresentative struct {
+	Tokens []int32 `protobuf:"varint,1,rep,packed,name=tokens,proto3" json:"tokens,omitempty"`
+	Head   int32   `protobuf:"varint,2,opt,name=head,proto3" json:"head,omitempty"`
+}
+
+func (m *CoreferenceRepresentative) Reset()         { *m = CoreferenceRepresentative{} }
+func (m *CoreferenceRepresentative) String() string { return proto.CompactTextString(m) }
+func (*CoreferenceRepresentative) ProtoMessage()    {}
+
+type CoreferenceReferents struct {
+	Tokens      []int32 `protobuf:"varint,1,rep,packed,name=tokens,proto3" json:"tokens,omitempty"`
+	Head        int32   `protobuf:"varint,2,opt,name=head,proto3" json:"head,omitempty"`
+	Probability float64 `protobuf:"fixed64,3,opt,name=probability,proto3" json:"probability,omitempty"`
+}
+
+func (m *CoreferenceReferents) Reset()         { *m = CoreferenceReferents{} }
+func (m *CoreferenceReferents) String() string { return proto.CompactTextString(m) }
+func (*CoreferenceReferents) ProtoMessage()    {}
+
+type Coreference struct {
+	Id             int32                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Representative *CoreferenceRepresentative `protobuf:"bytes,2,opt,name=representative,proto3" json:"representative,omitempty"`
+	Referents      []*CoreferenceReferents    `protobuf:"bytes,3,rep,name=referents,proto3" json:"referents,omitempty"`
+}
+
+func (m *Coreference) Reset()         { *m = Coreference{} }
+func (m *Coreference) String() string { return proto.CompactTextString(m) }
+func (*Coreference) ProtoMessage()    {}
+
+func (m *Coreference) GetRepresentative() *CoreferenceRepresentative {
+	if m != nil {
+		return m.Representative
+	}
+	return nil
+}
+
+func (m *Coreference) GetReferents() []*CoreferenceReferents {
+	if m != nil {
+		return m.Referents
+	}
+	return nil
+}
+
+type Scope struct {
+	Id         int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Governor   []int32 `protobuf:"varint,2,rep,packed,name=governor,proto3" json:"governor,omitempty"`
+	Dependents []int32 `protobuf:"varint,3,rep,packed,name=dependents,proto3" json:"dependents,omitempty"`
+	Terminals  []int32 `protobuf:"varint,4,rep,packed,name=terminals,proto3" json:"terminals,omitempty"`
+}
+
+func (m *Scope) Reset()         { *m = Scope{} }
+func (m *Scope) String() string { return proto.CompactTextString(m) }
+func (*Scope) ProtoMessage()    {}
+
+type ConstituentParse struct {
+	SentenceId        int32    `protobuf:"varint,1,opt,name=sentence_id,json=sentenceId,proto3" json:"sentence_id,omitempty"`
+	Type              string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	LabeledBracketing string   `protobuf:"bytes,3,opt,name=labeled_bracketing,json=labeledBracketing,proto3" json:"labeled_bracketing,omitempty"`
+	Probability       float64  `protobuf:"fixed64,4,opt,name=probability,proto3" json:"probability,omitempty"`
+	Scopes            []*Scope `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`
+}
+
+func (m *ConstituentParse) Reset()         { *m = ConstituentParse{} }
+func (m *ConstituentParse) String() string { return proto.CompactTextString(m) }
+func (*ConstituentParse) ProtoMessage()    {}
+
+func (m *ConstituentParse) GetScopes() []*Scope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+type Expression struct {
+	Id          int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type        string  `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Head        int32   `protobuf:"varint,3,opt,name=head,proto3" json:"head,omitempty"`
+	Dependency  string  `protobuf:"bytes,4,opt,name=dependency,proto3" json:"dependency,omitempty"`
+	TokenFrom   int32   `protobuf:"varint,5,opt,name=token_from,json=tokenFrom,proto3" json:"token_from,omitempty"`
+	TokenTo     int32   `protobuf:"varint,6,opt,name=token_to,json=tokenTo,proto3" json:"token_to,omitempty"`
+	Tokens      []int32 `protobuf:"varint,7,rep,packed,name=tokens,proto3" json:"tokens,omitempty"`
+	Probability float64 `protobuf:"fixed64,8,opt,name=probability,proto3" json:"probability,omitempty"`
+}
+
+func (m *Expression) Reset()         { *m = Expression{} }
+func (m *Expression) String() string { return proto.CompactTextString(m) }
+func (*Expression) ProtoMessage()    {}
+
+type Paragraph struct {
+	Id        int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TokenFrom int32   `protobuf:"varint,2,opt,name=token_from,json=tokenFrom,proto3" json:"token_from,omitempty"`
+	TokenTo   int32   `protobuf:"varint,3,opt,name=token_to,json=tokenTo,proto3" json:"token_to,omitempty"`
+	Tokens    []int32 `protobuf:"varint,4,rep,packed,name=tokens,proto3" json:"tokens,omitempty"`
+	Sentences []int32 `protobuf:"varint,5,rep,packed,name=sentences,proto3" json:"sentences,omitempty"`
+}
+
+func (m *Paragraph) Reset()         { *m = Paragraph{} }
+func (m *Paragraph) String() string { return proto.CompactTextString(m) }
+func (*Paragraph) ProtoMessage()    {}
+
+type Attribute struct {
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Attribute) Reset()         { *m = Attribute{} }
+func (m *Attribute) String() string { return proto.CompactTextString(m) }
+func (*Attribute) ProtoMessage()    {}
+
+type Entity struct {
+	Id                   int32        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label                string       `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Type                 string       `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Url                  string       `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Head                 int32        `protobuf:"varint,5,opt,name=head,proto3" json:"head,omitempty"`
+	TokenFrom            int32        `protobuf:"varint,6,opt,name=token_from,json=tokenFrom,proto3" json:"token_from,omitempty"`
+	TokenTo              int32        `protobuf:"varint,7,opt,name=token_to,json=tokenTo,proto3" json:"token_to,omitempty"`
+	Tokens               []int32      `protobuf:"varint,8,rep,packed,name=tokens,proto3" json:"tokens,omitempty"`
+	TripleId             int32        `protobuf:"varint,9,opt,name=triple_id,json=tripleId,proto3" json:"triple_id,omitempty"`
+	Sentiment            string       `protobuf:"bytes,10,opt,name=sentiment,proto3" json:"sentiment,omitempty"`
+	SentimentProbability float64      `protobuf:"fixed64,11,opt,name=sentiment_probability,json=sentimentProbability,proto3" json:"sentiment_probability,omitempty"`
+	Count                int32        `protobuf:"varint,12,opt,name=count,proto3" json:"count,omitempty"`
+	Attributes           []*Attribute `protobuf:"bytes,13,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (m *Entity) Reset()         { *m = Entity{} }
+func (m *Entity) String() string { return proto.CompactTextString(m) }
+func (*Entity) ProtoMessage()    {}
+
+func (m *Entity) GetAttributes() []*Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+type Relation struct {
+	Id                   int32        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label                string       `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Type                 string       `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Url                  string       `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Head                 int32        `protobuf:"varint,5,opt,name=head,proto3" json:"head,omitempty"`
+	TokenFrom            int32        `protobuf:"varint,6,opt,name=token_from,json=tokenFrom,proto3" json:"token_from,omitempty"`
+	TokenTo              int32        `protobuf:"varint,7,opt,name=token_to,json=tokenTo,proto3" json:"token_to,omitempty"`
+	Tokens               []int32      `protobuf:"varint,8,rep,packed,name=tokens,proto3" json:"tokens,omitempty"`
+	Sentiment            string       `protobuf:"bytes,9,opt,name=sentiment,proto3" json:"sentiment,omitempty"`
+	SentimentProbability float64      `protobuf:"fixed64,10,opt,name=sentiment_probability,json=sentimentProbability,proto3" json:"sentiment_probability,omitempty"`
+	Count                int32        `protobuf:"varint,11,opt,name=count,proto3" json:"count,omitempty"`
+	Attributes           []*Attribute `protobuf:"bytes,12,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (m *Relation) Reset()         { *m = Relation{} }
+func (m *Relation) String() string { return proto.CompactTextString(m) }
+func (*Relation) ProtoMessage()    {}
+
+func (m *Relation) GetAttributes() []*Attribute {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+type Triple struct {
+	Id               int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromEntity       int32   `protobuf:"varint,2,opt,name=from_entity,json=fromEntity,proto3" json:"from_entity,omitempty"`
+	ToEntity         int32   `protobuf:"varint,3,opt,name=to_entity,json=toEntity,proto3" json:"to_entity,omitempty"`
+	Relation         int32   `protobuf:"varint,4,opt,name=relation,proto3" json:"relation,omitempty"`
+	ClauseId         []int32 `protobuf:"varint,5,rep,packed,name=clause_id,json=clauseId,proto3" json:"clause_id,omitempty"`
+	SentenceId       []int32 `protobuf:"varint,6,rep,packed,name=sentence_id,json=sentenceId,proto3" json:"sentence_id,omitempty"`
+	Directional      bool    `protobuf:"varint,7,opt,name=directional,proto3" json:"directional,omitempty"`
+	EventId          int32   `protobuf:"varint,8,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	TemporalSequence int32   `protobuf:"varint,9,opt,name=temporal_sequence,json=temporalSequence,proto3" json:"temporal_sequence,omitempty"`
+	Probability      float64 `protobuf:"fixed64,10,opt,name=probability,proto3" json:"probability,omitempty"`
+	Syntactic        bool    `protobuf:"varint,11,opt,name=syntactic,proto3" json:"syntactic,omitempty"`
+	Implied          bool    `protobuf:"varint,12,opt,name=implied,proto3" json:"implied,omitempty"`
+	Presupposed      bool    `protobuf:"varint,13,opt,name=presupposed,proto3" json:"presupposed,omitempty"`
+	Count            int32   `protobuf:"varint,14,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *Triple) Reset()         { *m = Triple{} }
+func (m *Triple) String() string { return proto.CompactTextString(m) }
+func (*Triple) ProtoMessage()    {}
+
+type Timex struct {
+	Tid       string `protobuf:"bytes,1,opt,name=tid,proto3" json:"tid,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Value     string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	AltValue  string `protobuf:"bytes,4,opt,name=alt_value,json=altValue,proto3" json:"alt_value,omitempty"`
+	TokenFrom int32  `protobuf:"varint,5,opt,name=token_from,json=tokenFrom,proto3" json:"token_from,omitempty"`
+	TokenTo   int32  `protobuf:"varint,6,opt,name=token_to,json=tokenTo,proto3" json:"token_to,omitempty"`
+}
+
+func (m *Timex) Reset()         { *m = Timex{} }
+func (m *Timex) String() string { return proto.CompactTextString(m) }
+func (*Timex) ProtoMessage()    {}
+
+type Quote struct {
+	Id          int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Speaker     string  `protobuf:"bytes,2,opt,name=speaker,proto3" json:"speaker,omitempty"`
+	Mention     string  `protobuf:"bytes,3,opt,name=mention,proto3" json:"mention,omitempty"`
+	Begin       int32   `protobuf:"varint,4,opt,name=begin,proto3" json:"begin,omitempty"`
+	End         int32   `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+	SentenceIds []int32 `protobuf:"varint,6,rep,packed,name=sentence_ids,json=sentenceIds,proto3" json:"sentence_ids,omitempty"`
+}
+
+func (m *Quote) Reset()         { *m = Quote{} }
+func (m *Quote) String() string { return proto.CompactTextString(m) }
+func (*Quote) ProtoMessage()    {}
+
+type NERMention struct {
+	Id              int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TokenFrom       int32  `protobuf:"varint,2,opt,name=token_from,json=tokenFrom,proto3" json:"token_from,omitempty"`
+	TokenTo         int32  `protobuf:"varint,3,opt,name=token_to,json=tokenTo,proto3" json:"token_to,omitempty"`
+	Text            string `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Entity          string `protobuf:"bytes,5,opt,name=entity,proto3" json:"entity,omitempty"`
+	NormalizedValue string `protobuf:"bytes,6,opt,name=normalized_value,json=normalizedValue,proto3" json:"normalized_value,omitempty"`
+	Gender          string `protobuf:"bytes,7,opt,name=gender,proto3" json:"gender,omitempty"`
+	EntityLink      string `protobuf:"bytes,8,opt,name=entity_link,json=entityLink,proto3" json:"entity_link,omitempty"`
+}
+
+func (m *NERMention) Reset()         { *m = NERMention{} }
+func (m *NERMention) String() string { return proto.CompactTextString(m) }
+func (*NERMention) ProtoMessage()    {}
+
+type Document struct {
+	Meta            *Meta               `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	Id              int32               `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	TokenList       []*Token            `protobuf:"bytes,3,rep,name=token_list,json=tokenList,proto3" json:"token_list,omitempty"`
+	Clauses         []*Clause           `protobuf:"bytes,4,rep,name=clauses,proto3" json:"clauses,omitempty"`
+	Sentences       []*Sentence         `protobuf:"bytes,5,rep,name=sentences,proto3" json:"sentences,omitempty"`
+	Paragraphs      []*Paragraph        `protobuf:"bytes,6,rep,name=paragraphs,proto3" json:"paragraphs,omitempty"`
+	DependencyTrees []*DependencyTree   `protobuf:"bytes,7,rep,name=dependency_trees,json=dependencyTrees,proto3" json:"dependency_trees,omitempty"`
+	Coreferences    []*Coreference      `protobuf:"bytes,8,rep,name=coreferences,proto3" json:"coreferences,omitempty"`
+	Constituents    []*ConstituentParse `protobuf:"bytes,9,rep,name=constituents,proto3" json:"constituents,omitempty"`
+	Expressions     []*Expression       `protobuf:"bytes,10,rep,name=expressions,proto3" json:"expressions,omitempty"`
+	Entities        []*Entity           `protobuf:"bytes,11,rep,name=entities,proto3" json:"entities,omitempty"`
+	Relations       []*Relation         `protobuf:"bytes,12,rep,name=relations,proto3" json:"relations,omitempty"`
+	Triples         []*Triple           `protobuf:"bytes,13,rep,name=triples,proto3" json:"triples,omitempty"`
+	Timexes         []*Timex            `protobuf:"bytes,14,rep,name=timexes,proto3" json:"timexes,omitempty"`
+	Quotes          []*Quote            `protobuf:"bytes,15,rep,name=quotes,proto3" json:"quotes,omitempty"`
+	Mentions        []*NERMention       `protobuf:"bytes,16,rep,name=mentions,proto3" json:"mentions,omitempty"`
+}
+
+func (m *Document) Reset()         { *m = Document{} }
+func (m *Document) String() string { return proto.CompactTextString(m) }
+func (*Document) ProtoMessage()    {}
+
+func (m *Document) GetMeta() *Meta {
+	if m != nil {
+		return m.Meta
+	}
+	return nil
+}
+
+func (m *Document) GetTokenList() []*Token {
+	if m != nil {
+		return m.TokenList
+	}
+	return nil
+}
+
+func (m *Document) GetClauses() []*Clause {
+	if m != nil {
+		return m.Clauses
+	}
+	return nil
+}
+
+func (m *Document) GetSentences() []*Sentence {
+	if m != nil {
+		return m.Sentences
+	}
+	return nil
+}
+
+func (m *Document) GetParagraphs() []*Paragraph {
+	if m != nil {
+		return m.Paragraphs
+	}
+	return nil
+}
+
+func (m *Document) GetDependencyTrees() []*DependencyTree {
+	if m != nil {
+		return m.DependencyTrees
+	}
+	return nil
+}
+
+func (m *Document) GetCoreferences() []*Coreference {
+	if m != nil {
+		return m.Coreferences
+	}
+	return nil
+}
+
+func (m *Document) GetConstituents() []*ConstituentParse {
+	if m != nil {
+		return m.Constituents
+	}
+	return nil
+}
+
+func (m *Document) GetExpressions() []*Expression {
+	if m != nil {
+		return m.Expressions
+	}
+	return nil
+}
+
+func (m *Document) GetEntities() []*Entity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+func (m *Document) GetRelations() []*Relation {
+	if m != nil {
+		return m.Relations
+	}
+	return nil
+}
+
+func (m *Document) GetTriples() []*Triple {
+	if m != nil {
+		return m.Triples
+	}
+	return nil
+}
+
+func (m *Document) GetTimexes() []*Timex {
+	if m != nil {
+		return m.Timexes
+	}
+	return nil
+}
+
+func (m *Document) GetQuotes() []*Quote {
+	if m != nil {
+		return m.Quotes
+	}
+	return nil
+}
+
+func (m *Document) GetMentions() []*NERMention {
+	if m != nil {
+		return m.Mentions
+	}
+	return nil
+}
+
+type TextRequest struct {
+	Text     string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+}
+
+func (m *TextRequest) Reset()         { *m = TextRequest{} }
+func (m *TextRequest) String() string { return proto.CompactTextString(m) }
+func (*TextRequest) ProtoMessage()    {}
+
+// --- Meta field accessors ---
+
+func (m *Meta) GetDcConformsTo() string {
+	if m != nil {
+		return m.DcConformsTo
+	}
+	return ""
+}
+
+func (m *Meta) GetDcAuthor() string {
+	if m != nil {
+		return m.DcAuthor
+	}
+	return ""
+}
+
+func (m *Meta) GetDcCreated() string {
+	if m != nil {
+		return m.DcCreated
+	}
+	return ""
+}
+
+func (m *Meta) GetDcDate() string {
+	if m != nil {
+		return m.DcDate
+	}
+	return ""
+}
+
+func (m *Meta) GetDcSource() string {
+	if m != nil {
+		return m.DcSource
+	}
+	return ""
+}
+
+func (m *Meta) GetDcLanguage() string {
+	if m != nil {
+		return m.DcLanguage
+	}
+	return ""
+}
+
+func (m *Meta) GetDcCreator() string {
+	if m != nil {
+		return m.DcCreator
+	}
+	return ""
+}
+
+func (m *Meta) GetDcPublisher() string {
+	if m != nil {
+		return m.DcPublisher
+	}
+	return ""
+}
+
+func (m *Meta) GetDcTitle() string {
+	if m != nil {
+		return m.DcTitle
+	}
+	return ""
+}
+
+func (m *Meta) GetDcDescription() string {
+	if m != nil {
+		return m.DcDescription
+	}
+	return ""
+}
+
+func (m *Meta) GetDcIdentifier() string {
+	if m != nil {
+		return m.DcIdentifier
+	}
+	return ""
+}
+
+// --- TokenFeatures field accessors ---
+
+func (m *TokenFeatures) GetOvert() bool {
+	if m != nil {
+		return m.Overt
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetStop() bool {
+	if m != nil {
+		return m.Stop
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetAlpha() bool {
+	if m != nil {
+		return m.Alpha
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetNumber() int32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *TokenFeatures) GetGender() string {
+	if m != nil {
+		return m.Gender
+	}
+	return ""
+}
+
+func (m *TokenFeatures) GetPerson() int32 {
+	if m != nil {
+		return m.Person
+	}
+	return 0
+}
+
+func (m *TokenFeatures) GetTense() string {
+	if m != nil {
+		return m.Tense
+	}
+	return ""
+}
+
+func (m *TokenFeatures) GetPerfect() bool {
+	if m != nil {
+		return m.Perfect
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetContinuous() bool {
+	if m != nil {
+		return m.Continuous
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetProgressive() bool {
+	if m != nil {
+		return m.Progressive
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetCase() string {
+	if m != nil {
+		return m.Case
+	}
+	return ""
+}
+
+func (m *TokenFeatures) GetHuman() bool {
+	if m != nil {
+		return m.Human
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetAnimate() bool {
+	if m != nil {
+		return m.Animate
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetNegated() bool {
+	if m != nil {
+		return m.Negated
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetCountable() bool {
+	if m != nil {
+		return m.Countable
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetFactive() bool {
+	if m != nil {
+		return m.Factive
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetCounterfactive() bool {
+	if m != nil {
+		return m.Counterfactive
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetIrregular() bool {
+	if m != nil {
+		return m.Irregular
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetPhrasalVerb() bool {
+	if m != nil {
+		return m.PhrasalVerb
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetMood() string {
+	if m != nil {
+		return m.Mood
+	}
+	return ""
+}
+
+func (m *TokenFeatures) GetForeign() bool {
+	if m != nil {
+		return m.Foreign
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetSpaceAfter() bool {
+	if m != nil {
+		return m.SpaceAfter
+	}
+	return false
+}
+
+func (m *TokenFeatures) GetVoice() string {
+	if m != nil {
+		return m.Voice
+	}
+	return ""
+}
+
+// --- Token field accessors ---
+
+func (m *Token) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Token) GetSentenceId() int32 {
+	if m != nil {
+		return m.SentenceId
+	}
+	return 0
+}
+
+func (m *Token) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *Token) GetLemma() string {
+	if m != nil {
+		return m.Lemma
+	}
+	return ""
+}
+
+func (m *Token) GetXpos() string {
+	if m != nil {
+		return m.Xpos
+	}
+	return ""
+}
+
+func (m *Token) GetXposProb() float64 {
+	if m != nil {
+		return m.XposProb
+	}
+	return 0
+}
+
+func (m *Token) GetUpos() string {
+	if m != nil {
+		return m.Upos
+	}
+	return ""
+}
+
+func (m *Token) GetUposProb() float64 {
+	if m != nil {
+		return m.UposProb
+	}
+	return 0
+}
+
+func (m *Token) GetEntityIob() string {
+	if m != nil {
+		return m.EntityIob
+	}
+	return ""
+}
+
+func (m *Token) GetCharacterOffsetBegin() int32 {
+	if m != nil {
+		return m.CharacterOffsetBegin
+	}
+	return 0
+}
+
+func (m *Token) GetCharacterOffsetEnd() int32 {
+	if m != nil {
+		return m.CharacterOffsetEnd
+	}
+	return 0
+}
+
+func (m *Token) GetPropId() string {
+	if m != nil {
+		return m.PropId
+	}
+	return ""
+}
+
+func (m *Token) GetPropIdProbability() float64 {
+	if m != nil {
+		return m.PropIdProbability
+	}
+	return 0
+}
+
+func (m *Token) GetFrameId() int32 {
+	if m != nil {
+		return m.FrameId
+	}
+	return 0
+}
+
+func (m *Token) GetFrameIdProbability() float64 {
+	if m != nil {
+		return m.FrameIdProbability
+	}
+	return 0
+}
+
+func (m *Token) GetWordNetId() int32 {
+	if m != nil {
+		return m.WordNetId
+	}
+	return 0
+}
+
+func (m *Token) GetWordNetIdProbability() float64 {
+	if m != nil {
+		return m.WordNetIdProbability
+	}
+	return 0
+}
+
+func (m *Token) GetVerbNetId() int32 {
+	if m != nil {
+		return m.VerbNetId
+	}
+	return 0
+}
+
+func (m *Token) GetVerbNetIdProbability() float64 {
+	if m != nil {
+		return m.VerbNetIdProbability
+	}
+	return 0
+}
+
+func (m *Token) GetLang() string {
+	if m != nil {
+		return m.Lang
+	}
+	return ""
+}
+
+func (m *Token) GetShape() string {
+	if m != nil {
+		return m.Shape
+	}
+	return ""
+}
+
+func (m *Token) GetEntity() string {
+	if m != nil {
+		return m.Entity
+	}
+	return ""
+}
+
+// --- Sentence field accessors ---
+
+func (m *Sentence) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Sentence) GetTokenFrom() int32 {
+	if m != nil {
+		return m.TokenFrom
+	}
+	return 0
+}
+
+func (m *Sentence) GetTokenTo() int32 {
+	if m != nil {
+		return m.TokenTo
+	}
+	return 0
+}
+
+func (m *Sentence) GetTokens() []int32 {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *Sentence) GetClauses() []int32 {
+	if m != nil {
+		return m.Clauses
+	}
+	return nil
+}
+
+func (m *Sentence) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Sentence) GetSentiment() string {
+	if m != nil {
+		return m.Sentiment
+	}
+	return ""
+}
+
+func (m *Sentence) GetSentimentProbability() float64 {
+	if m != nil {
+		return m.SentimentProbability
+	}
+	return 0
+}
+
+// --- Clause field accessors ---
+
+func (m *Clause) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Clause) GetSentenceId() int32 {
+	if m != nil {
+		return m.SentenceId
+	}
+	return 0
+}
+
+func (m *Clause) GetTokenFrom() int32 {
+	if m != nil {
+		return m.TokenFrom
+	}
+	return 0
+}
+
+func (m *Clause) GetTokenTo() int32 {
+	if m != nil {
+		return m.TokenTo
+	}
+	return 0
+}
+
+func (m *Clause) GetTokens() []int32 {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *Clause) GetMain() bool {
+	if m != nil {
+		return m.Main
+	}
+	return false
+}
+
+func (m *Clause) GetGovernor() int32 {
+	if m != nil {
+		return m.Governor
+	}
+	return 0
+}
+
+func (m *Clause) GetHead() int32 {
+	if m != nil {
+		return m.Head
+	}
+	return 0
+}
+
+func (m *Clause) GetNegation() bool {
+	if m != nil {
+		return m.Negation
+	}
+	return false
+}
+
+func (m *Clause) GetTense() string {
+	if m != nil {
+		return m.Tense
+	}
+	return ""
+}
+
+func (m *Clause) GetMood() string {
+	if m != nil {
+		return m.Mood
+	}
+	return ""
+}
+
+func (m *Clause) GetPerfect() bool {
+	if m != nil {
+		return m.Perfect
+	}
+	return false
+}
+
+func (m *Clause) GetContinuous() bool {
+	if m != nil {
+		return m.Continuous
+	}
+	return false
+}
+
+func (m *Clause) GetAspect() string {
+	if m != nil {
+		return m.Aspect
+	}
+	return ""
+}
+
+func (m *Clause) GetVoice() string {
+	if m != nil {
+		return m.Voice
+	}
+	return ""
+}
+
+func (m *Clause) GetSentiment() string {
+	if m != nil {
+		return m.Sentiment
+	}
+	return ""
+}
+
+func (m *Clause) GetSentimentProbability() float64 {
+	if m != nil {
+		return m.SentimentProbability
+	}
+	return 0
+}
+
+// --- Dependency field accessors ---
+
+func (m *Dependency) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *Dependency) GetGovernor() int32 {
+	if m != nil {
+		return m.Governor
+	}
+	return 0
+}
+
+func (m *Dependency) GetDependent() int32 {
+	if m != nil {
+		return m.Dependent
+	}
+	return 0
+}
+
+func (m *Dependency) GetProbability() float64 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
+// --- DependencyTree field accessors ---
+
+func (m *DependencyTree) GetSentenceId() int32 {
+	if m != nil {
+		return m.SentenceId
+	}
+	return 0
+}
+
+func (m *DependencyTree) GetStyle() string {
+	if m != nil {
+		return m.Style
+	}
+	return ""
+}
+
+func (m *DependencyTree) GetProbability() float64 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
+func (m *DependencyTree) GetHashOverHeads() int32 {
+	if m != nil {
+		return m.HashOverHeads
+	}
+	return 0
+}
+
+// --- CoreferenceRepresentative field accessors ---
+
+func (m *CoreferenceRepresentative) GetTokens() []int32 {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *CoreferenceRepresentative) GetHead() int32 {
+	if m != nil {
+		return m.Head
+	}
+	return 0
+}
+
+// --- CoreferenceReferents field accessors ---
+
+func (m *CoreferenceReferents) GetTokens() []int32 {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *CoreferenceReferents) GetHead() int32 {
+	if m != nil {
+		return m.Head
+	}
+	return 0
+}
+
+func (m *CoreferenceReferents) GetProbability() float64 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
+// --- Coreference field accessors ---
+
+func (m *Coreference) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// --- Scope field accessors ---
+
+func (m *Scope) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Scope) GetGovernor() []int32 {
+	if m != nil {
+		return m.Governor
+	}
+	return nil
+}
+
+func (m *Scope) GetDependents() []int32 {
+	if m != nil {
+		return m.Dependents
+	}
+	return nil
+}
+
+func (m *Scope) GetTerminals() []int32 {
+	if m != nil {
+		return m.Terminals
+	}
+	return nil
+}
+
+// --- ConstituentParse field accessors ---
+
+func (m *ConstituentParse) GetSentenceId() int32 {
+	if m != nil {
+		return m.SentenceId
+	}
+	return 0
+}
+
+func (m *ConstituentParse) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ConstituentParse) GetLabeledBracketing() string {
+	if m != nil {
+		return m.LabeledBracketing
+	}
+	return ""
+}
+
+func (m *ConstituentParse) GetProbability() float64 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
+// --- Expression field accessors ---
+
+func (m *Expression) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Expression) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Expression) GetHead() int32 {
+	if m != nil {
+		return m.Head
+	}
+	return 0
+}
+
+func (m *Expression) GetDependency() string {
+	if m != nil {
+		return m.Dependency
+	}
+	return ""
+}
+
+func (m *Expression) GetTokenFrom() int32 {
+	if m != nil {
+		return m.TokenFrom
+	}
+	return 0
+}
+
+func (m *Expression) GetTokenTo() int32 {
+	if m != nil {
+		return m.TokenTo
+	}
+	return 0
+}
+
+func (m *Expression) GetTokens() []int32 {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *Expression) GetProbability() float64 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
+// --- Paragraph field accessors ---
+
+func (m *Paragraph) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Paragraph) GetTokenFrom() int32 {
+	if m != nil {
+		return m.TokenFrom
+	}
+	return 0
+}
+
+func (m *Paragraph) GetTokenTo() int32 {
+	if m != nil {
+		return m.TokenTo
+	}
+	return 0
+}
+
+func (m *Paragraph) GetTokens() []int32 {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *Paragraph) GetSentences() []int32 {
+	if m != nil {
+		return m.Sentences
+	}
+	return nil
+}
+
+// --- Attribute field accessors ---
+
+func (m *Attribute) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *Attribute) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// --- Entity field accessors ---
+
+func (m *Entity) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Entity) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *Entity) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Entity) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Entity) GetHead() int32 {
+	if m != nil {
+		return m.Head
+	}
+	return 0
+}
+
+func (m *Entity) GetTokenFrom() int32 {
+	if m != nil {
+		return m.TokenFrom
+	}
+	return 0
+}
+
+func (m *Entity) GetTokenTo() int32 {
+	if m != nil {
+		return m.TokenTo
+	}
+	return 0
+}
+
+func (m *Entity) GetTokens() []int32 {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *Entity) GetTripleId() int32 {
+	if m != nil {
+		return m.TripleId
+	}
+	return 0
+}
+
+func (m *Entity) GetSentiment() string {
+	if m != nil {
+		return m.Sentiment
+	}
+	return ""
+}
+
+func (m *Entity) GetSentimentProbability() float64 {
+	if m != nil {
+		return m.SentimentProbability
+	}
+	return 0
+}
+
+func (m *Entity) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// --- Relation field accessors ---
+
+func (m *Relation) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Relation) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *Relation) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Relation) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Relation) GetHead() int32 {
+	if m != nil {
+		return m.Head
+	}
+	return 0
+}
+
+func (m *Relation) GetTokenFrom() int32 {
+	if m != nil {
+		return m.TokenFrom
+	}
+	return 0
+}
+
+func (m *Relation) GetTokenTo() int32 {
+	if m != nil {
+		return m.TokenTo
+	}
+	return 0
+}
+
+func (m *Relation) GetTokens() []int32 {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *Relation) GetSentiment() string {
+	if m != nil {
+		return m.Sentiment
+	}
+	return ""
+}
+
+func (m *Relation) GetSentimentProbability() float64 {
+	if m != nil {
+		return m.SentimentProbability
+	}
+	return 0
+}
+
+func (m *Relation) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// --- Triple field accessors ---
+
+func (m *Triple) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Triple) GetFromEntity() int32 {
+	if m != nil {
+		return m.FromEntity
+	}
+	return 0
+}
+
+func (m *Triple) GetToEntity() int32 {
+	if m != nil {
+		return m.ToEntity
+	}
+	return 0
+}
+
+func (m *Triple) GetRelation() int32 {
+	if m != nil {
+		return m.Relation
+	}
+	return 0
+}
+
+func (m *Triple) GetClauseId() []int32 {
+	if m != nil {
+		return m.ClauseId
+	}
+	return nil
+}
+
+func (m *Triple) GetSentenceId() []int32 {
+	if m != nil {
+		return m.SentenceId
+	}
+	return nil
+}
+
+func (m *Triple) GetDirectional() bool {
+	if m != nil {
+		return m.Directional
+	}
+	return false
+}
+
+func (m *Triple) GetEventId() int32 {
+	if m != nil {
+		return m.EventId
+	}
+	return 0
+}
+
+func (m *Triple) GetTemporalSequence() int32 {
+	if m != nil {
+		return m.TemporalSequence
+	}
+	return 0
+}
+
+func (m *Triple) GetProbability() float64 {
+	if m != nil {
+		return m.Probability
+	}
+	return 0
+}
+
+func (m *Triple) GetSyntactic() bool {
+	if m != nil {
+		return m.Syntactic
+	}
+	return false
+}
+
+func (m *Triple) GetImplied() bool {
+	if m != nil {
+		return m.Implied
+	}
+	return false
+}
+
+func (m *Triple) GetPresupposed() bool {
+	if m != nil {
+		return m.Presupposed
+	}
+	return false
+}
+
+func (m *Triple) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+// --- Timex field accessors ---
+
+func (m *Timex) GetTid() string {
+	if m != nil {
+		return m.Tid
+	}
+	return ""
+}
+
+func (m *Timex) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Timex) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Timex) GetAltValue() string {
+	if m != nil {
+		return m.AltValue
+	}
+	return ""
+}
+
+func (m *Timex) GetTokenFrom() int32 {
+	if m != nil {
+		return m.TokenFrom
+	}
+	return 0
+}
+
+func (m *Timex) GetTokenTo() int32 {
+	if m != nil {
+		return m.TokenTo
+	}
+	return 0
+}
+
+// --- Quote field accessors ---
+
+func (m *Quote) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Quote) GetSpeaker() string {
+	if m != nil {
+		return m.Speaker
+	}
+	return ""
+}
+
+func (m *Quote) GetMention() string {
+	if m != nil {
+		return m.Mention
+	}
+	return ""
+}
+
+func (m *Quote) GetBegin() int32 {
+	if m != nil {
+		return m.Begin
+	}
+	return 0
+}
+
+func (m *Quote) GetEnd() int32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *Quote) GetSentenceIds() []int32 {
+	if m != nil {
+		return m.SentenceIds
+	}
+	return nil
+}
+
+// --- NERMention field accessors ---
+
+func (m *NERMention) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *NERMention) GetTokenFrom() int32 {
+	if m != nil {
+		return m.TokenFrom
+	}
+	return 0
+}
+
+func (m *NERMention) GetTokenTo() int32 {
+	if m != nil {
+		return m.TokenTo
+	}
+	return 0
+}
+
+func (m *NERMention) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *NERMention) GetEntity() string {
+	if m != nil {
+		return m.Entity
+	}
+	return ""
+}
+
+func (m *NERMention) GetNormalizedValue() string {
+	if m != nil {
+		return m.NormalizedValue
+	}
+	return ""
+}
+
+func (m *NERMention) GetGender() string {
+	if m != nil {
+		return m.Gender
+	}
+	return ""
+}
+
+func (m *NERMention) GetEntityLink() string {
+	if m != nil {
+		return m.EntityLink
+	}
+	return ""
+}
+
+// --- Document field accessors ---
+
+func (m *Document) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// --- TextRequest field accessors ---
+
+func (m *TextRequest) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *TextRequest) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Meta)(nil), "jsonnlp.Meta")
+	proto.RegisterType((*TokenFeatures)(nil), "jsonnlp.TokenFeatures")
+	proto.RegisterType((*Token)(nil), "jsonnlp.Token")
+	proto.RegisterType((*Sentence)(nil), "jsonnlp.Sentence")
+	proto.RegisterType((*Clause)(nil), "jsonnlp.Clause")
+	proto.RegisterType((*Dependency)(nil), "jsonnlp.Dependency")
+	proto.RegisterType((*DependencyTree)(nil), "jsonnlp.DependencyTree")
+	proto.RegisterType((*CoreferenceRepresentative)(nil), "jsonnlp.CoreferenceRepresentative")
+	proto.RegisterType((*CoreferenceReferents)(nil), "jsonnlp.CoreferenceReferents")
+	proto.RegisterType((*Coreference)(nil), "jsonnlp.Coreference")
+	proto.RegisterType((*Scope)(nil), "jsonnlp.Scope")
+	proto.RegisterType((*ConstituentParse)(nil), "jsonnlp.ConstituentParse")
+	proto.RegisterType((*Expression)(nil), "jsonnlp.Expression")
+	proto.RegisterType((*Paragraph)(nil), "jsonnlp.Paragraph")
+	proto.RegisterType((*Attribute)(nil), "jsonnlp.Attribute")
+	proto.RegisterType((*Entity)(nil), "jsonnlp.Entity")
+	proto.RegisterType((*Relation)(nil), "jsonnlp.Relation")
+	proto.RegisterType((*Triple)(nil), "jsonnlp.Triple")
+	proto.RegisterType((*Timex)(nil), "jsonnlp.Timex")
+	proto.RegisterType((*Quote)(nil), "jsonnlp.Quote")
+	proto.RegisterType((*NERMention)(nil), "jsonnlp.NERMention")
+	proto.RegisterType((*Document)(nil), "jsonnlp.Document")
+	proto.RegisterType((*TextRequest)(nil), "jsonnlp.TextRequest")
+}
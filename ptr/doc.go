@@ -0,0 +1,11 @@
+/**
+ * JSONNLP package
+ * (C) 2020 by Semiring Inc., Damir Cavar
+ *
+ * Package ptr implements RFC 6901 JSON Pointer resolution and RFC 6902 JSON
+ * Patch application directly against a *jsonnlp.JSONNLP value, by walking the
+ * struct with reflection instead of round-tripping through
+ * map[string]interface{}.
+ */
+
+package ptr // import "github.com/SemiringInc/jsonnlp/ptr"
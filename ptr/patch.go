@@ -0,0 +1,98 @@
+package ptr
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/SemiringInc/jsonnlp"
+)
+
+// operation is one entry of an RFC 6902 JSON Patch document.
+type operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// ApplyPatch decodes patch as an RFC 6902 JSON Patch document and applies
+// its operations to data in order. Operations are applied eagerly; if one
+// fails partway through, data is left with the preceding operations
+// already applied.
+func ApplyPatch(data *jsonnlp.JSONNLP, patch []byte) error {
+	var ops []operation
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return fmt.Errorf("ptr: malformed patch document: %w", err)
+	}
+	for _, op := range ops {
+		if err := applyOperation(data, op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyOperation(data *jsonnlp.JSONNLP, op operation) error {
+	switch op.Op {
+	case "add":
+		v, err := decodeValue(op.Value)
+		if err != nil {
+			return err
+		}
+		return add(data, op.Path, v)
+	case "remove":
+		return remove(data, op.Path)
+	case "replace":
+		if _, err := Get(data, op.Path); err != nil {
+			return err
+		}
+		v, err := decodeValue(op.Value)
+		if err != nil {
+			return err
+		}
+		return Set(data, op.Path, v)
+	case "move":
+		v, err := Get(data, op.From)
+		if err != nil {
+			return err
+		}
+		if err := remove(data, op.From); err != nil {
+			return err
+		}
+		return add(data, op.Path, v)
+	case "copy":
+		v, err := Get(data, op.From)
+		if err != nil {
+			return err
+		}
+		return add(data, op.Path, v)
+	case "test":
+		want, err := decodeValue(op.Value)
+		if err != nil {
+			return err
+		}
+		got, err := Get(data, op.Path)
+		if err != nil {
+			return err
+		}
+		gotJSON, _ := json.Marshal(got)
+		wantJSON, _ := json.Marshal(want)
+		if string(gotJSON) != string(wantJSON) {
+			return fmt.Errorf("ptr: test failed at %q", op.Path)
+		}
+		return nil
+	default:
+		return fmt.Errorf("ptr: unknown patch operation %q", op.Op)
+	}
+}
+
+func decodeValue(raw json.RawMessage) (interface{}, error) {
+	var v interface{}
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
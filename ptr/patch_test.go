@@ -0,0 +1,62 @@
+package ptr
+
+import "testing"
+
+// TestApplyPatchAddInsertsNotOverwrites is a regression test for the "add"
+// op: adding at an existing array index must insert and shift the later
+// elements right, not overwrite the element already there.
+func TestApplyPatchAddInsertsNotOverwrites(t *testing.T) {
+	data := sampleDoc()
+
+	patch := []byte(`[
+		{"op": "add", "path": "/documents/0/tokenList/1", "value": {"id": 9, "text": "quickly"}}
+	]`)
+	if err := ApplyPatch(data, patch); err != nil {
+		t.Fatalf("ApplyPatch: %v", err)
+	}
+
+	tokens := data.Documents[0].TokenList
+	if len(tokens) != 3 {
+		t.Fatalf("got %d tokens, want 3", len(tokens))
+	}
+	if tokens[0].Text != "Alice" || tokens[1].Text != "quickly" || tokens[2].Text != "ran" {
+		t.Errorf("unexpected order: %+v", tokens)
+	}
+}
+
+func TestApplyPatchReplace(t *testing.T) {
+	data := sampleDoc()
+
+	patch := []byte(`[{"op": "replace", "path": "/documents/0/tokenList/0/text", "value": "Bob"}]`)
+	if err := ApplyPatch(data, patch); err != nil {
+		t.Fatalf("ApplyPatch: %v", err)
+	}
+	if len(data.Documents[0].TokenList) != 2 {
+		t.Fatalf("got %d tokens, want 2", len(data.Documents[0].TokenList))
+	}
+	if data.Documents[0].TokenList[0].Text != "Bob" {
+		t.Errorf("got %q, want %q", data.Documents[0].TokenList[0].Text, "Bob")
+	}
+}
+
+func TestApplyPatchRemove(t *testing.T) {
+	data := sampleDoc()
+
+	patch := []byte(`[{"op": "remove", "path": "/documents/0/tokenList/0"}]`)
+	if err := ApplyPatch(data, patch); err != nil {
+		t.Fatalf("ApplyPatch: %v", err)
+	}
+	tokens := data.Documents[0].TokenList
+	if len(tokens) != 1 || tokens[0].Text != "ran" {
+		t.Errorf("got %+v", tokens)
+	}
+}
+
+func TestApplyPatchTestFails(t *testing.T) {
+	data := sampleDoc()
+
+	patch := []byte(`[{"op": "test", "path": "/documents/0/tokenList/0/text", "value": "nope"}]`)
+	if err := ApplyPatch(data, patch); err == nil {
+		t.Fatal("ApplyPatch: expected an error from a failing test op")
+	}
+}
@@ -0,0 +1,300 @@
+package ptr
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/SemiringInc/jsonnlp"
+)
+
+// splitPointer decodes an RFC 6901 JSON Pointer into its unescaped reference
+// tokens. The empty pointer "" refers to the whole document and decodes to
+// no tokens.
+func splitPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("ptr: pointer must start with '/': %q", pointer)
+	}
+	parts := strings.Split(pointer[1:], "/")
+	for i, p := range parts {
+		p = strings.ReplaceAll(p, "~1", "/")
+		p = strings.ReplaceAll(p, "~0", "~")
+		parts[i] = p
+	}
+	return parts, nil
+}
+
+// fieldByJSONName finds the struct field of v whose json tag (or, lacking
+// one, Go field name) matches name.
+func fieldByJSONName(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		jsonName := strings.Split(tag, ",")[0]
+		if jsonName == "-" {
+			continue
+		}
+		if jsonName == "" {
+			jsonName = field.Name
+		}
+		if jsonName == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// step descends one reference token into v, which must be a struct, slice,
+// or array (after dereferencing any pointers).
+func step(v reflect.Value, token string) (reflect.Value, error) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}, &ErrDanglingRef{Pointer: token}
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		f, ok := fieldByJSONName(v, token)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("ptr: unknown field %q", token)
+		}
+		return f, nil
+	case reflect.Slice, reflect.Array:
+		if token == "-" {
+			return reflect.Value{}, fmt.Errorf(`ptr: "-" is not a valid token for reading`)
+		}
+		idx, err := strconv.Atoi(token)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("ptr: invalid array index %q", token)
+		}
+		if idx < 0 || idx >= v.Len() {
+			return reflect.Value{}, fmt.Errorf("ptr: array index %d out of range", idx)
+		}
+		return v.Index(idx), nil
+	default:
+		return reflect.Value{}, fmt.Errorf("ptr: cannot descend into %s at %q", v.Kind(), token)
+	}
+}
+
+// walk resolves every token in tokens and returns the value they reference.
+// Set, add, and remove pass all but the last token, so that they get back
+// the container (a struct or a slice) holding it and can assign to, insert
+// at, or remove the last token themselves.
+func walk(root reflect.Value, tokens []string) (reflect.Value, error) {
+	v := root
+	var err error
+	for _, t := range tokens {
+		v, err = step(v, t)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+	}
+	return v, nil
+}
+
+// Get resolves a JSON Pointer against data and returns the referenced value.
+func Get(data *jsonnlp.JSONNLP, pointer string) (interface{}, error) {
+	tokens, err := splitPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	v, err := walk(reflect.ValueOf(data).Elem(), tokens)
+	if err != nil {
+		return nil, err
+	}
+	return v.Interface(), nil
+}
+
+// Set resolves pointer to its parent container and assigns v to the
+// referenced field or array element. A trailing "-" token appends v to the
+// array at pointer's parent, and an index equal to an array's length also
+// appends, per RFC 6902.
+func Set(data *jsonnlp.JSONNLP, pointer string, v interface{}) error {
+	tokens, err := splitPointer(pointer)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("ptr: cannot replace the document root")
+	}
+	container, err := walk(reflect.ValueOf(data).Elem(), tokens[:len(tokens)-1])
+	if err != nil {
+		return err
+	}
+	return setAt(container, tokens[len(tokens)-1], v)
+}
+
+func setAt(container reflect.Value, token string, value interface{}) error {
+	for container.Kind() == reflect.Ptr {
+		container = container.Elem()
+	}
+	switch container.Kind() {
+	case reflect.Struct:
+		f, ok := fieldByJSONName(container, token)
+		if !ok {
+			return fmt.Errorf("ptr: unknown field %q", token)
+		}
+		return assign(f, value)
+	case reflect.Slice:
+		if token == "-" {
+			return appendAt(container, value)
+		}
+		idx, err := strconv.Atoi(token)
+		if err != nil {
+			return fmt.Errorf("ptr: invalid array index %q", token)
+		}
+		if idx == container.Len() {
+			return appendAt(container, value)
+		}
+		if idx < 0 || idx > container.Len() {
+			return fmt.Errorf("ptr: array index %d out of range", idx)
+		}
+		return assign(container.Index(idx), value)
+	default:
+		return fmt.Errorf("ptr: cannot set a value on %s", container.Kind())
+	}
+}
+
+// add resolves pointer to its parent container and inserts v there with
+// RFC 6902 "add" semantics: a struct field is assigned like Set, but an
+// existing array index is inserted before (shifting later elements right)
+// rather than overwritten.
+func add(data *jsonnlp.JSONNLP, pointer string, v interface{}) error {
+	tokens, err := splitPointer(pointer)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("ptr: cannot replace the document root")
+	}
+	container, err := walk(reflect.ValueOf(data).Elem(), tokens[:len(tokens)-1])
+	if err != nil {
+		return err
+	}
+	return insertAt(container, tokens[len(tokens)-1], v)
+}
+
+func insertAt(container reflect.Value, token string, value interface{}) error {
+	for container.Kind() == reflect.Ptr {
+		container = container.Elem()
+	}
+	switch container.Kind() {
+	case reflect.Struct:
+		f, ok := fieldByJSONName(container, token)
+		if !ok {
+			return fmt.Errorf("ptr: unknown field %q", token)
+		}
+		return assign(f, value)
+	case reflect.Slice:
+		if token == "-" {
+			return appendAt(container, value)
+		}
+		idx, err := strconv.Atoi(token)
+		if err != nil {
+			return fmt.Errorf("ptr: invalid array index %q", token)
+		}
+		if idx == container.Len() {
+			return appendAt(container, value)
+		}
+		if idx < 0 || idx > container.Len() {
+			return fmt.Errorf("ptr: array index %d out of range", idx)
+		}
+		elem := reflect.New(container.Type().Elem()).Elem()
+		if err := assign(elem, value); err != nil {
+			return err
+		}
+		grown := reflect.Append(container, reflect.Zero(container.Type().Elem()))
+		reflect.Copy(grown.Slice(idx+1, grown.Len()), grown.Slice(idx, grown.Len()-1))
+		grown.Index(idx).Set(elem)
+		container.Set(grown)
+		return nil
+	default:
+		return fmt.Errorf("ptr: cannot set a value on %s", container.Kind())
+	}
+}
+
+func appendAt(container reflect.Value, value interface{}) error {
+	elem := reflect.New(container.Type().Elem()).Elem()
+	if err := assign(elem, value); err != nil {
+		return err
+	}
+	container.Set(reflect.Append(container, elem))
+	return nil
+}
+
+// assign converts value into target's type via a JSON round-trip and sets
+// it. This lets callers pass plain Go values (ints, strings, maps coming
+// from a decoded patch document, ...) without a manual type switch over
+// every JSON-NLP field type.
+func assign(target reflect.Value, value interface{}) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	np := reflect.New(target.Type())
+	if err := json.Unmarshal(b, np.Interface()); err != nil {
+		return err
+	}
+	target.Set(np.Elem())
+	return nil
+}
+
+// removeAt zeroes a struct field or deletes a slice element in place.
+func removeAt(container reflect.Value, token string) error {
+	for container.Kind() == reflect.Ptr {
+		container = container.Elem()
+	}
+	switch container.Kind() {
+	case reflect.Struct:
+		f, ok := fieldByJSONName(container, token)
+		if !ok {
+			return fmt.Errorf("ptr: unknown field %q", token)
+		}
+		f.Set(reflect.Zero(f.Type()))
+		return nil
+	case reflect.Slice:
+		idx, err := strconv.Atoi(token)
+		if err != nil {
+			return fmt.Errorf("ptr: invalid array index %q", token)
+		}
+		if idx < 0 || idx >= container.Len() {
+			return fmt.Errorf("ptr: array index %d out of range", idx)
+		}
+		container.Set(reflect.AppendSlice(container.Slice(0, idx), container.Slice(idx+1, container.Len())))
+		return nil
+	default:
+		return fmt.Errorf("ptr: cannot remove a value from %s", container.Kind())
+	}
+}
+
+func remove(data *jsonnlp.JSONNLP, pointer string) error {
+	tokens, err := splitPointer(pointer)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("ptr: cannot remove the document root")
+	}
+	container, err := walk(reflect.ValueOf(data).Elem(), tokens[:len(tokens)-1])
+	if err != nil {
+		return err
+	}
+	return removeAt(container, tokens[len(tokens)-1])
+}
+
+// ErrDanglingRef is returned when a pointer passes through a nil field on
+// its way to the referenced value.
+type ErrDanglingRef struct {
+	Pointer string
+}
+
+func (e *ErrDanglingRef) Error() string {
+	return fmt.Sprintf("ptr: dangling reference at %q", e.Pointer)
+}
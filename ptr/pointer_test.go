@@ -0,0 +1,59 @@
+package ptr
+
+import (
+	"testing"
+
+	"github.com/SemiringInc/jsonnlp"
+)
+
+func sampleDoc() *jsonnlp.JSONNLP {
+	return &jsonnlp.JSONNLP{
+		Documents: []jsonnlp.Document{
+			{
+				ID: 1,
+				TokenList: []jsonnlp.Token{
+					{ID: 1, Text: "Alice"},
+					{ID: 2, Text: "ran"},
+				},
+			},
+		},
+	}
+}
+
+func TestGet(t *testing.T) {
+	data := sampleDoc()
+
+	got, err := Get(data, "/documents/0/tokenList/1/text")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	if got != "ran" {
+		t.Errorf("got %v, want %q", got, "ran")
+	}
+}
+
+func TestSetReplacesInPlace(t *testing.T) {
+	data := sampleDoc()
+
+	if err := Set(data, "/documents/0/tokenList/0/text", "Bob"); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if len(data.Documents[0].TokenList) != 2 {
+		t.Fatalf("got %d tokens, want 2", len(data.Documents[0].TokenList))
+	}
+	if data.Documents[0].TokenList[0].Text != "Bob" {
+		t.Errorf("got %q, want %q", data.Documents[0].TokenList[0].Text, "Bob")
+	}
+}
+
+func TestSetAppend(t *testing.T) {
+	data := sampleDoc()
+
+	if err := Set(data, "/documents/0/tokenList/-", map[string]interface{}{"id": 3, "text": "fast"}); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	tokens := data.Documents[0].TokenList
+	if len(tokens) != 3 || tokens[2].Text != "fast" {
+		t.Errorf("got %+v", tokens)
+	}
+}
@@ -0,0 +1,46 @@
+/**
+ * JSONNLP package
+ * (C) 2020 by Semiring Inc., Damir Cavar
+ *
+ * TIMEX3 temporal expressions, quotes, and NER mentions, as routinely
+ * emitted by pipelines such as CoreNLP but absent from the original
+ * JSON-NLP schema.
+ */
+
+package jsonnlp
+
+// Timex is a TIMEX3 temporal expression, e.g. a date, time, duration, or
+// recurring set picked out by a temporal tagger such as SUTime.
+type Timex struct {
+	TID       string `json:"tid"`
+	Type      string `json:"type,omitempty"`     // DATE, TIME, DURATION, SET
+	Value     string `json:"value,omitempty"`    // TIMEX3 value, e.g. "2020-05-28"
+	AltValue  string `json:"altValue,omitempty"` // alternative/underspecified value
+	TokenFrom int    `json:"tokenFrom,omitempty"`
+	TokenTo   int    `json:"tokenTo,omitempty"`
+}
+
+// Quote is a direct quotation identified in the text, together with its
+// attributed speaker.
+type Quote struct {
+	ID          int    `json:"id"`
+	Speaker     string `json:"speaker,omitempty"`
+	Mention     string `json:"mention,omitempty"` // the quoted text
+	Begin       int    `json:"begin,omitempty"`   // first token of the quote
+	End         int    `json:"end,omitempty"`     // last token of the quote
+	SentenceIDs []int  `json:"sentenceIDs,omitempty"`
+}
+
+// NERMention is a single named entity mention as tagged by a sequence
+// labeler, carrying its normalized value (e.g. a money or date amount), the
+// referent's gender, and a canonical link for coreference/entity linking.
+type NERMention struct {
+	ID              int    `json:"id"`
+	TokenFrom       int    `json:"tokenFrom,omitempty"`
+	TokenTo         int    `json:"tokenTo,omitempty"`
+	Text            string `json:"text,omitempty"`
+	Entity          string `json:"entity,omitempty"`          // NER type, e.g. PERSON, MONEY, DATE
+	NormalizedValue string `json:"normalizedValue,omitempty"` // normalized NER value
+	Gender          string `json:"gender,omitempty"`
+	EntityLink      string `json:"entityLink,omitempty"` // canonical entity/KB link
+}
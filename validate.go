@@ -0,0 +1,114 @@
+/**
+ * JSONNLP package
+ * (C) 2020 by Semiring Inc., Damir Cavar
+ *
+ * schema invariant checks that the Document structs do not enforce on
+ * their own.
+ */
+
+package jsonnlp
+
+import "fmt"
+
+// ErrUnknownToken is returned by Validate when a field references a token
+// ID that is not present in the Document's TokenList.
+type ErrUnknownToken struct {
+	Field   string
+	TokenID int
+}
+
+func (e *ErrUnknownToken) Error() string {
+	return fmt.Sprintf("jsonnlp: %s references unknown token id %d", e.Field, e.TokenID)
+}
+
+// ErrDanglingRef is returned by Validate when a field references the ID of
+// another top-level record (a sentence, entity, or relation) that does not
+// exist in the Document, or when a coreference's representative head is not
+// one of that representative's own tokens.
+type ErrDanglingRef struct {
+	Field string
+	ID    int
+}
+
+func (e *ErrDanglingRef) Error() string {
+	return fmt.Sprintf("jsonnlp: %s references unknown id %d", e.Field, e.ID)
+}
+
+// Validate checks the JSON-NLP schema invariants that the Document structs
+// do not enforce on their own: that tokens point at sentences that exist,
+// that dependencies and triples point at tokens, entities, and relations
+// that exist, and that every coreference's representative head is one of
+// its own tokens. It returns the first invariant it finds broken, as a
+// *ErrDanglingRef or *ErrUnknownToken, so that callers can locate and
+// repair the offending record programmatically.
+func Validate(d *Document) error {
+	sentenceIDs := make(map[int]bool, len(d.Sentences))
+	for _, s := range d.Sentences {
+		sentenceIDs[s.ID] = true
+	}
+
+	tokenIDs := make(map[int]bool, len(d.TokenList))
+	for _, t := range d.TokenList {
+		tokenIDs[t.ID] = true
+	}
+
+	for _, t := range d.TokenList {
+		if !sentenceIDs[t.SentenceID] {
+			return &ErrDanglingRef{Field: "Token.SentenceID", ID: t.SentenceID}
+		}
+	}
+
+	for _, tree := range d.DependencyTrees {
+		for _, dep := range tree.Dependencies {
+			if !tokenIDs[dep.Governor] {
+				return &ErrUnknownToken{Field: "Dependency.Governor", TokenID: dep.Governor}
+			}
+			if !tokenIDs[dep.Dependent] {
+				return &ErrUnknownToken{Field: "Dependency.Dependent", TokenID: dep.Dependent}
+			}
+		}
+	}
+
+	for _, c := range d.Coreferences {
+		for _, tok := range c.Representative.Tokens {
+			if !tokenIDs[tok] {
+				return &ErrUnknownToken{Field: "Coreference.Representative.Tokens", TokenID: tok}
+			}
+		}
+		if c.Representative.Head != 0 && !containsInt(c.Representative.Tokens, c.Representative.Head) {
+			return &ErrDanglingRef{Field: "Coreference.Representative.Head", ID: c.Representative.Head}
+		}
+	}
+
+	entityIDs := make(map[int]bool, len(d.Entities))
+	for _, e := range d.Entities {
+		entityIDs[e.ID] = true
+	}
+	relationIDs := make(map[int]bool, len(d.Relations))
+	for _, r := range d.Relations {
+		relationIDs[r.ID] = true
+	}
+
+	for _, tr := range d.Triples {
+		if !entityIDs[tr.FromEntity] {
+			return &ErrDanglingRef{Field: "Triple.FromEntity", ID: tr.FromEntity}
+		}
+		if !entityIDs[tr.ToEntity] {
+			return &ErrDanglingRef{Field: "Triple.ToEntity", ID: tr.ToEntity}
+		}
+		if !relationIDs[tr.Relation] {
+			return &ErrDanglingRef{Field: "Triple.Relation", ID: tr.Relation}
+		}
+	}
+
+	return nil
+}
+
+func containsInt(haystack []int, needle int) bool {
+	for _, v := range haystack {
+		if v == needle {
+			return true
+		}
+	}
+	return false
+}
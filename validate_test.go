@@ -0,0 +1,88 @@
+package jsonnlp
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestValidateOK verifies that a Document whose cross-references are all
+// consistent passes Validate.
+func TestValidateOK(t *testing.T) {
+	doc := &Document{
+		TokenList: []Token{{ID: 1, SentenceID: 1}},
+		Sentences: []Sentence{{ID: 1}},
+		DependencyTrees: []DependencyTree{
+			{SentenceID: 1, Dependencies: []Dependency{{Governor: 1, Dependent: 1}}},
+		},
+		Coreferences: []Coreference{
+			{ID: 1, Representative: CoreferenceRepresentantive{Tokens: []int{1}, Head: 1}},
+		},
+		Entities:  []Entity{{ID: 1}},
+		Relations: []Relation{{ID: 1}},
+		Triples:   []Triple{{ID: 1, FromEntity: 1, ToEntity: 1, Relation: 1}},
+	}
+	if err := Validate(doc); err != nil {
+		t.Errorf("Validate = %v, want nil", err)
+	}
+}
+
+// TestValidateDanglingTokenSentence verifies Token.SentenceID pointing at a
+// sentence that doesn't exist is reported as an ErrDanglingRef.
+func TestValidateDanglingTokenSentence(t *testing.T) {
+	doc := &Document{
+		TokenList: []Token{{ID: 1, SentenceID: 99}},
+	}
+	err := Validate(doc)
+	var danglingErr *ErrDanglingRef
+	if !errors.As(err, &danglingErr) || danglingErr.Field != "Token.SentenceID" || danglingErr.ID != 99 {
+		t.Errorf("Validate = %v, want ErrDanglingRef{Token.SentenceID, 99}", err)
+	}
+}
+
+// TestValidateUnknownDependencyToken verifies a Dependency edge pointing at
+// a token ID absent from TokenList is reported as an ErrUnknownToken.
+func TestValidateUnknownDependencyToken(t *testing.T) {
+	doc := &Document{
+		TokenList:       []Token{{ID: 1, SentenceID: 1}},
+		Sentences:       []Sentence{{ID: 1}},
+		DependencyTrees: []DependencyTree{{SentenceID: 1, Dependencies: []Dependency{{Governor: 1, Dependent: 2}}}},
+	}
+	err := Validate(doc)
+	var unknownErr *ErrUnknownToken
+	if !errors.As(err, &unknownErr) || unknownErr.Field != "Dependency.Dependent" || unknownErr.TokenID != 2 {
+		t.Errorf("Validate = %v, want ErrUnknownToken{Dependency.Dependent, 2}", err)
+	}
+}
+
+// TestValidateCoreferenceHeadOutsideTokens verifies a coreference whose
+// representative head is not one of its own tokens is reported as an
+// ErrDanglingRef.
+func TestValidateCoreferenceHeadOutsideTokens(t *testing.T) {
+	doc := &Document{
+		TokenList: []Token{{ID: 1, SentenceID: 1}},
+		Sentences: []Sentence{{ID: 1}},
+		Coreferences: []Coreference{
+			{ID: 1, Representative: CoreferenceRepresentantive{Tokens: []int{1}, Head: 2}},
+		},
+	}
+	err := Validate(doc)
+	var danglingErr *ErrDanglingRef
+	if !errors.As(err, &danglingErr) || danglingErr.Field != "Coreference.Representative.Head" || danglingErr.ID != 2 {
+		t.Errorf("Validate = %v, want ErrDanglingRef{Coreference.Representative.Head, 2}", err)
+	}
+}
+
+// TestValidateTripleDanglingRefs verifies a Triple referencing an entity or
+// relation that doesn't exist is reported as an ErrDanglingRef.
+func TestValidateTripleDanglingRefs(t *testing.T) {
+	doc := &Document{
+		Entities:  []Entity{{ID: 1}},
+		Relations: []Relation{{ID: 1}},
+		Triples:   []Triple{{ID: 1, FromEntity: 1, ToEntity: 99, Relation: 1}},
+	}
+	err := Validate(doc)
+	var danglingErr *ErrDanglingRef
+	if !errors.As(err, &danglingErr) || danglingErr.Field != "Triple.ToEntity" || danglingErr.ID != 99 {
+		t.Errorf("Validate = %v, want ErrDanglingRef{Triple.ToEntity, 99}", err)
+	}
+}